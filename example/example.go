@@ -2,34 +2,30 @@ package main
 
 import (
 	"github.com/saffronjam/go-sfml/public/sfml"
-	"runtime"
 	"time"
 )
 
-func init() { runtime.LockOSThread() }
-
 func main() {
-	wnd := sfml.NewRenderWindow(sfml.VideoMode{
-		Width:        800,
-		Height:       600,
-		BitsPerPixel: 32,
-	}, "my window", uint32(sfml.Titlebar)|uint32(sfml.Close), &sfml.ContextSettings{
-		DepthBits:         0,
-		StencilBits:       0,
-		AntialiasingLevel: 0,
-		MajorVersion:      0,
-		MinorVersion:      0,
-		AttributeFlags:    0,
-		SRgbCapable:       false,
-	})
-	asNormalWindow := sfml.NewWindowFromHandle(wnd.SystemHandle(), &sfml.ContextSettings{
-		DepthBits:         0,
-		StencilBits:       0,
-		AntialiasingLevel: 0,
-		MajorVersion:      0,
-		MinorVersion:      0,
-		AttributeFlags:    0,
-		SRgbCapable:       false,
+	sfml.Main(run)
+}
+
+// run drives the window from its own goroutine. SFML calls must happen on the
+// main thread, so every frame goes through sfml.Do, which runs it there.
+func run() {
+	wnd := sfml.Call(func() *sfml.RenderWindow {
+		return sfml.NewRenderWindow(sfml.VideoMode{
+			Width:        800,
+			Height:       600,
+			BitsPerPixel: 32,
+		}, "my window", sfml.Titlebar|sfml.Close, &sfml.ContextSettings{
+			DepthBits:         0,
+			StencilBits:       0,
+			AntialiasingLevel: 0,
+			MajorVersion:      0,
+			MinorVersion:      0,
+			AttributeFlags:    0,
+			SRgbCapable:       false,
+		})
 	})
 
 	rect := sfml.NewRectangleShape()
@@ -43,45 +39,47 @@ func main() {
 	circle.SetOrigin(sfml.Vector2f{X: 10, Y: 10})
 	renderStates := sfml.RenderStatesDefault()
 
-	for wnd.IsOpen() {
-		position := wnd.Position()
+	for sfml.Call(wnd.IsOpen) {
+		sfml.Do(func() {
+			position := wnd.Position()
 
-		event, hasMore := wnd.PollEvent()
-		if hasMore {
-			switch event.EventType() {
-			case sfml.EvtKeyPressed:
-				keyEvent := event.(*sfml.KeyEvent)
-				if keyEvent.Code == sfml.KeyA {
-					circle.SetFillColor(sfml.Color{R: 0, G: 100, B: 0, A: 255})
-				}
+			for event := range wnd.Events() {
+				switch event.EventType() {
+				case sfml.EvtKeyPressed:
+					keyEvent := event.(*sfml.KeyEvent)
+					if keyEvent.Code == sfml.KeyA {
+						circle.SetFillColor(sfml.Color{R: 0, G: 100, B: 0, A: 255})
+					}
 
-				if keyEvent.Code == sfml.KeySpace {
-					renderStates.Transform.Rotate(1.0)
-					continue
-				}
-			case sfml.EvtKeyReleased:
-				keyEvent := event.(*sfml.KeyEvent)
-				if keyEvent.Code == sfml.KeyA {
+					if keyEvent.Code == sfml.KeySpace {
+						renderStates.Transform.Rotate(1.0)
+					}
+				case sfml.EvtKeyReleased:
+					keyEvent := event.(*sfml.KeyEvent)
+					if keyEvent.Code == sfml.KeyA {
+						circle.SetFillColor(sfml.Color{R: 255, G: 0, B: 0, A: 255})
+					}
+				case sfml.EvtClosed:
+					wnd.Close()
+				case sfml.EvtMouseEntered:
+					circle.SetFillColor(sfml.Color{R: 0, G: 255, B: 0, A: 255})
+				case sfml.EvtMouseLeft:
 					circle.SetFillColor(sfml.Color{R: 255, G: 0, B: 0, A: 255})
 				}
-			case sfml.EvtClosed:
-				wnd.Close()
-				continue
-			case sfml.EvtMouseEntered:
-				circle.SetFillColor(sfml.Color{R: 0, G: 255, B: 0, A: 255})
-			case sfml.EvtMouseLeft:
-				circle.SetFillColor(sfml.Color{R: 255, G: 0, B: 0, A: 255})
 			}
-		}
+			if !wnd.IsOpen() {
+				return
+			}
 
-		pos := sfml.MouseGetPosition(asNormalWindow)
-		rect.SetPosition(sfml.Vector2f{X: float32(pos.X), Y: float32(pos.Y)})
-		wnd.Clear(sfml.Color{R: uint8(position.X % 255), G: uint8(position.Y % 255), B: 0, A: 255})
+			pos := sfml.MouseGetPosition(wnd)
+			rect.SetPosition(sfml.Vector2f{X: float32(pos.X), Y: float32(pos.Y)})
+			wnd.Clear(sfml.Color{R: uint8(position.X % 255), G: uint8(position.Y % 255), B: 0, A: 255})
 
-		wnd.DrawRectangleShape(rect, renderStates)
-		wnd.DrawCircleShape(circle, sfml.RenderStatesDefault())
+			wnd.DrawRectangleShape(rect, renderStates)
+			wnd.DrawCircleShape(circle, sfml.RenderStatesDefault())
 
-		wnd.Display()
+			wnd.Display()
+		})
 	}
 
 	time.Sleep(5 * time.Second)
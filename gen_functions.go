@@ -4,9 +4,20 @@ import (
 	"fmt"
 	"github.com/saffronjam/go-sfml/internal/common"
 	"path"
+	"slices"
 	"strings"
 )
 
+// overloadFamily is a Go function folded from the variants of an OverloadOverride.
+type overloadFamily struct {
+	Name       string         // e.g. "MouseGetPosition"
+	Interface  string         // e.g. "WindowLike"
+	Parameters []common.Field // Parameters of the folded function
+	ParamIndex int            // Index of the parameter typed as Interface
+	ReturnType string
+	Variants   []common.Field // Unexported variant function per Go type, e.g. {"mouseGetPositionRenderWindow", "RenderWindow"}
+}
+
 func main() {
 	config, err := common.LoadConfig()
 	if err != nil {
@@ -25,21 +36,31 @@ func main() {
 
 	writer.HeaderFunctions()
 
+	// Methods written per Go receiver type, used to build the InterfaceOverrides.
+	receiverMethods := make(map[string][]common.FunctionHeader)
+
+	// Overload families, keyed and ordered by the Go name of the folded function.
+	families := make(map[string]*overloadFamily)
+	var familyOrder []string
+	functionNames := make(map[string]struct{})
 	for _, fn := range converter.RawFunctions {
-		originalName := fn.Name                         // e.g. "sfMouse_getPosition"
-		stripped := converter.StripPrefix(originalName) // e.g. "Mouse_getPosition"
-		parts := strings.SplitN(stripped, "_", 2)       // e.g. ["Mouse","getPosition"]
-		if len(parts) != 2 {                            // skip if not "Type_Method"
-			continue
-		}
+		functionNames[fn.Name] = struct{}{}
+	}
 
-		_, methodPart := parts[0], parts[1]
+	for _, fn := range converter.RawFunctions {
+		originalName := fn.Name                                       // e.g. "sfMouse_getPosition"
+		stripped := converter.StripPrefix(originalName)               // e.g. "Mouse_getPosition"
+		parts := strings.SplitN(stripped, "_", 2)                     // e.g. ["Mouse","getPosition"]
+		methodPart := parts[len(parts)-1]                             // e.g. "getPosition", or "Sleep" for "sfSleep"
 		goStaticMethod := converter.TranslateMethodName(stripped)     // e.g. false for "sfMouse_getPosition"
 		goReceiverMethod := converter.TranslateMethodName(methodPart) // e.g. "GetPosition"
 
 		paramsC := fn.Parameters
 		returnTypeC := fn.ReturnType                        // e.g. "sfVector2i" or "int"
 		goReturnType := converter.MapCToGoType(returnTypeC) // e.g. "Vector2i" or "int32"
+		if flagReturn := converter.IsFlagParam(originalName, "return"); flagReturn != nil {
+			goReturnType = converter.MapCToGoType(flagReturn.Type) // e.g. "TextStyle" instead of "uint32"
+		}
 
 		// Determine if this is a method with a receiver or a top-level function.
 		var receiverType string
@@ -70,7 +91,7 @@ func main() {
 			callArgs = append(callArgs, fmt.Sprintf("%svar0", ampersand))
 
 			returnType := goReturnType
-			if !converter.IsEnum(returnType) && !common.IsNativeGoType(goReturnType) {
+			if !converter.IsEnum(returnType) && !common.IsNativeGoType(goReturnType) && converter.IsNativeTypeOverride(returnTypeC) == nil {
 				// If the return type is not an enum, we need to prepend a pointer
 				returnType = common.MakePointerType(goReturnType)
 			}
@@ -83,10 +104,16 @@ func main() {
 				// Map the C type to Go type
 				pty := converter.MapCToGoType(cParam.Type)
 				goParam := common.SanitizeFieldName(common.Field{Name: pname, Type: pty})
+				if flagParam := converter.IsFlagParam(originalName, cParam.Name); flagParam != nil {
+					goParam.Type = converter.MapCToGoType(flagParam.Type) // e.g. "WindowStyle" instead of "uint32"
+				}
 
 				argVarName := fmt.Sprintf("var%d", len(functionBodyRows))
 
-				if structOverride, hasOverride := converter.StructOverrides[common.CleanCType(cParam.Type)]; hasOverride {
+				if native := converter.IsNativeTypeOverride(cParam.Type); native != nil {
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("%s := %s(%s)", argVarName, native.ToC, goParam.Name))
+					callArgs = append(callArgs, argVarName)
+				} else if structOverride, hasOverride := converter.StructOverrides[common.CleanCType(cParam.Type)]; hasOverride {
 					sliceParam := converter.IsSliceParam(originalName, cParam.Name)
 					returnParam := converter.IsReturnParam(originalName, cParam.Name)
 
@@ -104,6 +131,8 @@ func main() {
 
 						functionBodyRows = append(functionBodyRows, fmt.Sprintf("%sCount := %s(len(%s))", argVarName, common.TypeConverterToC(countParamType), goParam.Name))
 						functionBodyRows = append(functionBodyRows, fmt.Sprintf("%sArray := New%sCArrayFromGoSlice(%s)", argVarName, common.StripPointer(goParam.Type), goParam.Name))
+						// SFML copies the elements during the call, so the temporary array can go right after
+						functionBodyRows = append(functionBodyRows, fmt.Sprintf("defer C.free(unsafe.Pointer(%sArray))", argVarName))
 						callArgs = append(callArgs, fmt.Sprintf("%sArray, %sCount", argVarName, argVarName))
 						// Overwrite the goParam to be the array type
 						goParam.Type = fmt.Sprintf("[]%s", common.StripPointer(goParam.Type))
@@ -139,6 +168,11 @@ func main() {
 					}
 
 					callArgs = append(callArgs, fmt.Sprintf("%s%s", dereference, argVarName))
+				} else if goParam.Type == "string" && common.IsUnicodeStringType(cParam.Type) {
+					// UTF-32 strings are copied to a temporary C buffer, which SFML copies again
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("%s := %s(stringToUTF32(%s))", argVarName, common.TypeConverterToC(cParam.Type), goParam.Name))
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("defer C.free(unsafe.Pointer(%s))", argVarName))
+					callArgs = append(callArgs, argVarName)
 				} else if goParam.Type == "string" {
 					// If the parameter is a string, we need to convert it to a C string
 					functionBodyRows = append(functionBodyRows, fmt.Sprintf("%s := C.CString(%s)", argVarName, goParam.Name))
@@ -166,6 +200,14 @@ func main() {
 				Parameters:   goParams,
 				ReturnType:   returnType,
 			})
+			if converter.IsMainThreadFunction(originalName) {
+				writer.FunctionBody(common.FunctionBody{Rows: []string{"assertMainThread()"}})
+			}
+			receiverMethods[receiverType] = append(receiverMethods[receiverType], common.FunctionHeader{
+				MethodName: goReceiverMethod,
+				Parameters: goParams,
+				ReturnType: returnType,
+			})
 
 			callExpr := fmt.Sprintf("C.%s(%s)", originalName, strings.Join(callArgs, ", "))
 			if common.IsVoidReturnType(returnType) {
@@ -183,8 +225,12 @@ func main() {
 					functionBodyRows = append(functionBodyRows, fmt.Sprintf("returnParamRes := New%sFromC(returnParam)", common.StripPointer(structOverride.GoName)))
 				}
 
-				if converter.IsKnownGoType(returnType) && !converter.IsEnum(returnType) {
+				if native := converter.IsNativeTypeOverride(returnTypeC); native != nil {
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("res := %s(funcRes0)", native.FromC))
+				} else if converter.IsKnownGoType(returnType) && !converter.IsEnum(returnType) {
 					functionBodyRows = append(functionBodyRows, fmt.Sprintf("res := New%sFromC(funcRes0)", common.StripPointer(goReturnType)))
+				} else if common.IsUnicodeStringType(returnTypeC) {
+					functionBodyRows = append(functionBodyRows, "res := utf32ToString(unsafe.Pointer(funcRes0))")
 				} else {
 					functionBodyRows = append(functionBodyRows, fmt.Sprintf("res := %s(funcRes0)", common.TypeConverterToGo(goReturnType)))
 				}
@@ -200,6 +246,7 @@ func main() {
 			var goParams []common.Field
 			var functionBodyRows []string
 			var callArgs []string
+			overload, overloadIndex, baseName := overloadVariant(converter, fn, functionNames)
 
 			for i, cParam := range paramsC {
 				pname := cParam.Name
@@ -208,10 +255,16 @@ func main() {
 				}
 				pty := converter.MapCToGoType(cParam.Type)
 				goParam := common.SanitizeFieldName(common.Field{Name: pname, Type: pty})
+				if flagParam := converter.IsFlagParam(originalName, cParam.Name); flagParam != nil {
+					goParam.Type = converter.MapCToGoType(flagParam.Type) // e.g. "WindowStyle" instead of "uint32"
+				}
 
 				argVarName := fmt.Sprintf("var%d", len(functionBodyRows))
 
-				if _, hasOverride := converter.StructOverrides[common.CleanCType(cParam.Type)]; hasOverride {
+				if native := converter.IsNativeTypeOverride(cParam.Type); native != nil {
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("%s := %s(%s)", argVarName, native.ToC, goParam.Name))
+					callArgs = append(callArgs, argVarName)
+				} else if _, hasOverride := converter.StructOverrides[common.CleanCType(cParam.Type)]; hasOverride {
 					nilPointerOverride := converter.IsNilParamOverride(originalName, cParam.Name)
 					if nilPointerOverride != nil {
 						goParam.Type = common.MakePointerType(goParam.Type)
@@ -235,9 +288,20 @@ func main() {
 						}
 						callArgs = append(callArgs, fmt.Sprintf("%s%s", ampersand, argVarName))
 					}
+				} else if overload != nil && i == overloadIndex {
+					// The folded function passes nil through, which the C functions take as no window
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("var %s *C.%s = nil", argVarName, common.CleanCType(cParam.Type)))
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("if %s != nil {", goParam.Name))
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("  %s = %s.ToC()", argVarName, goParam.Name))
+					functionBodyRows = append(functionBodyRows, "}")
+					callArgs = append(callArgs, argVarName)
 				} else if converter.IsKnownGoType(common.StripPointer(goParam.Type)) && !converter.IsEnum(goParam.Type) {
 					functionBodyRows = append(functionBodyRows, fmt.Sprintf("%s := %s.ToC()", argVarName, goParam.Name))
 					callArgs = append(callArgs, argVarName)
+				} else if goParam.Type == "string" && common.IsUnicodeStringType(cParam.Type) {
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("%s := %s(stringToUTF32(%s))", argVarName, common.TypeConverterToC(cParam.Type), goParam.Name))
+					functionBodyRows = append(functionBodyRows, fmt.Sprintf("defer C.free(unsafe.Pointer(%s))", argVarName))
+					callArgs = append(callArgs, argVarName)
 				} else if goParam.Type == "string" {
 					nilParamOverride := converter.IsNilParamOverride(originalName, cParam.Name)
 					if nilParamOverride != nil {
@@ -259,19 +323,44 @@ func main() {
 			}
 
 			returnType := goReturnType
-			if !converter.IsEnum(returnType) && !common.IsNativeGoType(goReturnType) {
+			if !converter.IsEnum(returnType) && !common.IsNativeGoType(goReturnType) && converter.IsNativeTypeOverride(returnTypeC) == nil {
 				// If the return type is not an enum, we need to prepend a pointer
 				returnType = common.MakePointerType(goReturnType)
 			}
 
+			if overload != nil {
+				// Write the variant unexported, it is called by the folded function
+				goBaseName := converter.TranslateMethodName(converter.StripPrefix(baseName)) // e.g. "MouseGetPosition"
+				variantType := common.StripPointer(goParams[overloadIndex].Type)             // e.g. "RenderWindow"
+				goStaticMethod = strings.ToLower(goBaseName[:1]) + goBaseName[1:] + variantType
+
+				family, ok := families[goBaseName]
+				if !ok {
+					foldedParams := slices.Clone(goParams)
+					foldedParams[overloadIndex].Type = overload.Interface
+					family = &overloadFamily{Name: goBaseName, Interface: overload.Interface, Parameters: foldedParams, ParamIndex: overloadIndex, ReturnType: returnType}
+					families[goBaseName] = family
+					familyOrder = append(familyOrder, goBaseName)
+				}
+				family.Variants = append(family.Variants, common.Field{Name: goStaticMethod, Type: variantType})
+			}
+
 			// Determine return type for the function signature
 			writer.FunctionHeader(common.FunctionHeader{
 				MethodName: goStaticMethod,
 				Parameters: goParams,
 				ReturnType: returnType,
 			})
+			if converter.IsMainThreadFunction(originalName) {
+				writer.FunctionBody(common.FunctionBody{Rows: []string{"assertMainThread()"}})
+			}
+
+			if native := converter.IsNativeTypeOverride(returnTypeC); native != nil {
+				functionBodyRows = append(functionBodyRows, fmt.Sprintf("funcRes0 := C.%s(%s)", originalName, strings.Join(callArgs, ", ")))
 
-			if _, hasOverride := converter.StructOverrides[common.CleanCType(returnTypeC)]; hasOverride {
+				writer.FunctionBody(common.FunctionBody{Rows: functionBodyRows})
+				writer.ReturnValue(fmt.Sprintf("%s(funcRes0)", native.FromC))
+			} else if _, hasOverride := converter.StructOverrides[common.CleanCType(returnTypeC)]; hasOverride {
 
 				functionBodyRows = append(functionBodyRows, fmt.Sprintf("funcRes0 := C.%s(%s)", originalName, strings.Join(callArgs, ", ")))
 
@@ -297,6 +386,9 @@ func main() {
 							writer.FunctionBody(common.FunctionBody{Rows: functionBodyRows})
 							writer.ReturnValue(fmt.Sprintf("%s(%s)", common.TypeConverterToGo(goReturnType), callExpr))
 						}
+					} else if common.IsUnicodeStringType(returnTypeC) {
+						writer.FunctionBody(common.FunctionBody{Rows: functionBodyRows})
+						writer.ReturnValue(fmt.Sprintf("utf32ToString(unsafe.Pointer(%s))", callExpr))
 					} else {
 						writer.FunctionBody(common.FunctionBody{Rows: functionBodyRows})
 						writer.ReturnValue(fmt.Sprintf("%s(%s)", common.TypeConverterToGo(goReturnType), callExpr))
@@ -306,6 +398,45 @@ func main() {
 		}
 	}
 
+	for _, name := range familyOrder {
+		writeOverloadFamily(writer, families[name])
+	}
+
+	for _, override := range converter.InterfaceOverrides {
+		if converter.IsKnownGoType(override.GoName) {
+			panic(fmt.Sprintf("Interface '%s' conflicts with a generated type of the same name", override.GoName))
+		}
+		methods := sharedMethods(receiverMethods, override)
+
+		var dispatch *common.DispatchOverride
+		for i := range converter.DispatchOverrides {
+			if converter.DispatchOverrides[i].Interface == override.GoName {
+				dispatch = &converter.DispatchOverrides[i]
+			}
+		}
+
+		var drawn []common.FunctionHeader
+		if dispatch != nil {
+			drawn = dispatchedMethods(methods, *dispatch)
+			if len(drawn) == 0 {
+				panic(fmt.Sprintf("No %s<Type> methods found on interface '%s'", dispatch.Prefix, override.GoName))
+			}
+			methods = append(methods, common.FunctionHeader{
+				MethodName: dispatch.EntryPoint,
+				Parameters: []common.Field{{Name: strings.ToLower(dispatch.GoName[:1]) + dispatch.GoName[1:], Type: dispatch.GoName}, drawn[0].Parameters[1]},
+			})
+		}
+
+		writer.Interface(common.Interface{Name: override.GoName, Methods: methods})
+		for _, implementer := range override.Implementers {
+			writer.WriteString(fmt.Sprintf("var _ %s = (*%s)(nil)\n\n", override.GoName, implementer))
+		}
+
+		if dispatch != nil {
+			writeDispatch(writer, *dispatch, override, drawn)
+		}
+	}
+
 	err = writer.WriteToFile(path.Join(common.OutputDir, "go_functions.go"))
 	if err != nil {
 		panic(fmt.Sprintf("Failed to write to file: %v", err))
@@ -313,3 +444,170 @@ func main() {
 
 	fmt.Println("✅ Generated go_functions.go with correct Vector2*/Vector3f return handling.")
 }
+
+// sharedMethods returns the methods of the first implementer that every other
+// implementer has with the same signature, optionally restricted to override.Methods.
+func sharedMethods(receiverMethods map[string][]common.FunctionHeader, override common.InterfaceOverride) []common.FunctionHeader {
+	signature := func(method common.FunctionHeader) string {
+		types := make([]string, len(method.Parameters))
+		for i, param := range method.Parameters {
+			types[i] = param.Type
+		}
+		return fmt.Sprintf("%s(%s)%s", method.MethodName, strings.Join(types, ", "), method.ReturnType)
+	}
+
+	var methods []common.FunctionHeader
+	for _, method := range receiverMethods[override.Implementers[0]] {
+		if len(override.Methods) > 0 && !slices.Contains(override.Methods, method.MethodName) {
+			continue
+		}
+
+		shared := true
+		for _, implementer := range override.Implementers[1:] {
+			if !slices.ContainsFunc(receiverMethods[implementer], func(other common.FunctionHeader) bool {
+				return signature(other) == signature(method)
+			}) {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			methods = append(methods, method)
+		}
+	}
+
+	if len(methods) == 0 {
+		panic(fmt.Sprintf("No shared methods found for interface '%s'", override.GoName))
+	}
+	if len(override.Methods) > 0 && len(methods) != len(override.Methods) {
+		panic(fmt.Sprintf("Not every method of interface '%s' is shared by %s", override.GoName, strings.Join(override.Implementers, ", ")))
+	}
+	return methods
+}
+
+// dispatchedMethods returns the Prefix<Type>(object *Type, states) methods of an interface.
+func dispatchedMethods(methods []common.FunctionHeader, dispatch common.DispatchOverride) []common.FunctionHeader {
+	var drawn []common.FunctionHeader
+	for _, method := range methods {
+		drawnType := strings.TrimPrefix(method.MethodName, dispatch.Prefix)
+		if drawnType == method.MethodName || len(method.Parameters) != 2 || method.ReturnType != "" {
+			continue
+		}
+		if method.Parameters[0].Type == common.MakePointerType(drawnType) {
+			drawn = append(drawn, method)
+		}
+	}
+	return drawn
+}
+
+// writeDispatch writes the dispatch interface, its implementation on every drawn
+// type and the entry point on every implementer of the drawing interface.
+func writeDispatch(writer *common.Writer, dispatch common.DispatchOverride, override common.InterfaceOverride, drawn []common.FunctionHeader) {
+	statesParam := drawn[0].Parameters[1]
+	targetParam := common.Field{Name: "target", Type: override.GoName}
+	dispatchParam := common.Field{Name: strings.ToLower(dispatch.GoName[:1]) + dispatch.GoName[1:], Type: dispatch.GoName}
+
+	writer.Interface(common.Interface{
+		Name: dispatch.GoName,
+		Methods: []common.FunctionHeader{
+			{MethodName: dispatch.MethodName, Parameters: []common.Field{targetParam, statesParam}},
+		},
+	})
+
+	for _, method := range drawn {
+		drawnType := strings.TrimPrefix(method.MethodName, dispatch.Prefix)
+		receiverVar := strings.ToLower(drawnType[:1])
+		writer.ReceiverFunctionHeader(common.ReceiverFunctionHeader{
+			ReceiverName: receiverVar,
+			ReceiverType: common.MakePointerType(drawnType),
+			MethodName:   dispatch.MethodName,
+			Parameters:   []common.Field{targetParam, statesParam},
+		})
+		writer.FunctionBody(common.FunctionBody{Rows: []string{
+			fmt.Sprintf("%s.%s(%s, %s)", targetParam.Name, method.MethodName, receiverVar, statesParam.Name),
+		}})
+		writer.VoidReturn()
+		writer.WriteString(fmt.Sprintf("var _ %s = (*%s)(nil)\n\n", dispatch.GoName, drawnType))
+	}
+
+	for _, implementer := range override.Implementers {
+		receiverVar := strings.ToLower(implementer[:1])
+		writer.ReceiverFunctionHeader(common.ReceiverFunctionHeader{
+			ReceiverName: receiverVar,
+			ReceiverType: common.MakePointerType(implementer),
+			MethodName:   dispatch.EntryPoint,
+			Parameters:   []common.Field{dispatchParam, statesParam},
+		})
+		writer.FunctionBody(common.FunctionBody{Rows: []string{
+			fmt.Sprintf("%s.%s(%s, %s)", dispatchParam.Name, dispatch.MethodName, receiverVar, statesParam.Name),
+		}})
+		writer.VoidReturn()
+	}
+}
+
+// overloadVariant checks if fn is a variant of a complete OverloadOverrides family, and
+// returns the override, the index of the overloaded parameter and the C name of the family.
+func overloadVariant(converter *common.Converter, fn common.FunctionDecl, functionNames map[string]struct{}) (*common.OverloadOverride, int, string) {
+	for i := range converter.OverloadOverrides {
+		overload := &converter.OverloadOverrides[i]
+		for index, param := range fn.Parameters {
+			suffix, ok := overload.Variants[common.CleanCType(param.Type)]
+			if !ok || !strings.HasSuffix(fn.Name, suffix) {
+				continue
+			}
+
+			baseName := strings.TrimSuffix(fn.Name, suffix) // e.g. "sfMouse_getPosition"
+			complete := true
+			for _, otherSuffix := range overload.Variants {
+				if _, exists := functionNames[baseName+otherSuffix]; !exists {
+					complete = false
+					break
+				}
+			}
+			if complete {
+				return overload, index, baseName
+			}
+		}
+	}
+	return nil, 0, ""
+}
+
+// writeOverloadFamily writes the folded function, which switches on the type of the
+// overloaded parameter to call the matching variant. A nil parameter, typed or not,
+// reaches C as NULL.
+func writeOverloadFamily(writer *common.Writer, family *overloadFamily) {
+	writer.FunctionHeader(common.FunctionHeader{
+		MethodName: family.Name,
+		Parameters: family.Parameters,
+		ReturnType: family.ReturnType,
+	})
+
+	args := make([]string, len(family.Parameters))
+	for i, param := range family.Parameters {
+		args[i] = param.Name
+	}
+	overloaded := args[family.ParamIndex]
+
+	call := func(variant string, args []string) string {
+		callExpr := fmt.Sprintf("%s(%s)", variant, strings.Join(args, ", "))
+		if common.IsVoidReturnType(family.ReturnType) {
+			return "\t" + callExpr
+		}
+		return "\treturn " + callExpr
+	}
+
+	// A nil interface is passed on as a nil pointer, which the variants hand to C as NULL
+	nilArgs := slices.Clone(args)
+	nilArgs[family.ParamIndex] = "nil"
+	rows := []string{fmt.Sprintf("switch %s := %s.(type) {", overloaded, overloaded)}
+	rows = append(rows, "case nil:", call(family.Variants[0].Name, nilArgs))
+	for _, variant := range family.Variants {
+		rows = append(rows, fmt.Sprintf("case *%s:", variant.Type), call(variant.Name, args))
+	}
+	rows = append(rows, "default:")
+	rows = append(rows, fmt.Sprintf("\tpanic(\"sfml: %s does not support this %s implementation\")", family.Name, family.Interface))
+	rows = append(rows, "}")
+
+	writer.FunctionBody(common.FunctionBody{Rows: rows})
+	writer.VoidReturn()
+}
@@ -8,41 +8,106 @@ import (
 )
 
 // Defines the data structure for each vector type we want to generate.
-var vectorTypes = []struct {
-	Name       string   // e.g., "Vector2f"
-	TypeName   string   // e.g., "float32"
-	Components []string // e.g., []string{"X", "Y"}
-	HasFloat   bool     // True if the type is a float, for float-specific functions
+type vectorType struct {
+	Name        string       // e.g., "Vector2f"
+	TypeName    string       // e.g., "float32"
+	Suffix      string       // e.g., "f", used to name the swizzled vector types like "Vector2f"
+	Components  []string     // e.g., []string{"X", "Y"}
+	HasFloat    bool         // True if the type is a float, for float-specific functions
+	IsSigned    bool         // True if the type can be negative, for Abs, Negate, Cross and the like
+	Conversions []vectorType // The other vector types of the same size, filled in by main
+}
+
+var vectorTypes = []vectorType{
+	{Name: "Vector2f", TypeName: "float32", Suffix: "f", Components: []string{"X", "Y"}, HasFloat: true, IsSigned: true},
+	{Name: "Vector2d", TypeName: "float64", Suffix: "d", Components: []string{"X", "Y"}, HasFloat: true, IsSigned: true},
+	{Name: "Vector2i", TypeName: "int32", Suffix: "i", Components: []string{"X", "Y"}, HasFloat: false, IsSigned: true},
+	{Name: "Vector2u", TypeName: "uint32", Suffix: "u", Components: []string{"X", "Y"}, HasFloat: false, IsSigned: false},
+	{Name: "Vector3f", TypeName: "float32", Suffix: "f", Components: []string{"X", "Y", "Z"}, HasFloat: true, IsSigned: true},
+	{Name: "Vector3d", TypeName: "float64", Suffix: "d", Components: []string{"X", "Y", "Z"}, HasFloat: true, IsSigned: true},
+	{Name: "Vector3i", TypeName: "int32", Suffix: "i", Components: []string{"X", "Y", "Z"}, HasFloat: false, IsSigned: true},
+	{Name: "Vector3u", TypeName: "uint32", Suffix: "u", Components: []string{"X", "Y", "Z"}, HasFloat: false, IsSigned: false},
+	{Name: "Vector4f", TypeName: "float32", Suffix: "f", Components: []string{"X", "Y", "Z", "W"}, HasFloat: true, IsSigned: true},
+	{Name: "Vector4d", TypeName: "float64", Suffix: "d", Components: []string{"X", "Y", "Z", "W"}, HasFloat: true, IsSigned: true},
+	{Name: "Vector4i", TypeName: "int32", Suffix: "i", Components: []string{"X", "Y", "Z", "W"}, HasFloat: false, IsSigned: true},
+	{Name: "Vector4u", TypeName: "uint32", Suffix: "u", Components: []string{"X", "Y", "Z", "W"}, HasFloat: false, IsSigned: false},
+}
+
+// Defines the data structure for each rect type we want to generate.
+var rectTypes = []struct {
+	Name       string // e.g., "FloatRect"
+	TypeName   string // e.g., "float32"
+	VectorName string // e.g., "Vector2f", the type of positions and sizes
+	OtherName  string // e.g., "IntRect", the rect type it converts to
+	HasFloat   bool   // True if the type is a float, for float-specific functions
+}{
+	{Name: "IntRect", TypeName: "int32", VectorName: "Vector2i", OtherName: "FloatRect", HasFloat: false},
+	{Name: "FloatRect", TypeName: "float32", VectorName: "Vector2f", OtherName: "IntRect", HasFloat: true},
+}
+
+// Defines the data structure for each GLSL matrix type we want to generate.
+var matrixTypes = []struct {
+	Name string // e.g., "GlslMat3"
+	Size int    // e.g., 3 for a 3x3 matrix
+}{
+	{Name: "GlslMat3", Size: 3},
+	{Name: "GlslMat4", Size: 4},
+}
+
+// Defines the named colors written into the color addon's palette.
+var colorPalette = []struct {
+	Name       string // e.g., "Black"
+	R, G, B, A uint8
+}{
+	// The colors predefined by SFML
+	{Name: "Black", R: 0, G: 0, B: 0, A: 255},
+	{Name: "White", R: 255, G: 255, B: 255, A: 255},
+	{Name: "Red", R: 255, G: 0, B: 0, A: 255},
+	{Name: "Green", R: 0, G: 255, B: 0, A: 255},
+	{Name: "Blue", R: 0, G: 0, B: 255, A: 255},
+	{Name: "Yellow", R: 255, G: 255, B: 0, A: 255},
+	{Name: "Magenta", R: 255, G: 0, B: 255, A: 255},
+	{Name: "Cyan", R: 0, G: 255, B: 255, A: 255},
+	{Name: "Transparent", R: 0, G: 0, B: 0, A: 0},
+	// Common extras
+	{Name: "Gray", R: 128, G: 128, B: 128, A: 255},
+	{Name: "DarkGray", R: 64, G: 64, B: 64, A: 255},
+	{Name: "LightGray", R: 192, G: 192, B: 192, A: 255},
+	{Name: "Orange", R: 255, G: 165, B: 0, A: 255},
+	{Name: "Purple", R: 128, G: 0, B: 128, A: 255},
+	{Name: "Pink", R: 255, G: 192, B: 203, A: 255},
+	{Name: "Brown", R: 165, G: 42, B: 42, A: 255},
+}
+
+// Defines each addon file: the template that writes it and the data it gets.
+var addons = []struct {
+	Template string // e.g., "main.tpl"
+	Output   string // e.g., "go_addon_vector.go"
+	Data     any
 }{
-	{Name: "Vector2f", TypeName: "float32", Components: []string{"X", "Y"}, HasFloat: true},
-	{Name: "Vector2d", TypeName: "float64", Components: []string{"X", "Y"}, HasFloat: true},
-	{Name: "Vector2i", TypeName: "int32", Components: []string{"X", "Y"}, HasFloat: false},
-	{Name: "Vector2u", TypeName: "uint32", Components: []string{"X", "Y"}, HasFloat: false},
-	{Name: "Vector3f", TypeName: "float32", Components: []string{"X", "Y", "Z"}, HasFloat: true},
-	{Name: "Vector3d", TypeName: "float64", Components: []string{"X", "Y", "Z"}, HasFloat: true},
-	{Name: "Vector3i", TypeName: "int32", Components: []string{"X", "Y", "Z"}, HasFloat: false},
-	{Name: "Vector3u", TypeName: "uint32", Components: []string{"X", "Y", "Z"}, HasFloat: false},
-	{Name: "Vector4f", TypeName: "float32", Components: []string{"X", "Y", "Z", "W"}, HasFloat: true},
-	{Name: "Vector4d", TypeName: "float64", Components: []string{"X", "Y", "Z", "W"}, HasFloat: true},
-	{Name: "Vector4i", TypeName: "int32", Components: []string{"X", "Y", "Z", "W"}, HasFloat: false},
-	{Name: "Vector4u", TypeName: "uint32", Components: []string{"X", "Y", "Z", "W"}, HasFloat: false},
+	{Template: "main.tpl", Output: "go_addon_vector.go", Data: vectorTypes},
+	{Template: "color.tpl", Output: "go_addon_color.go", Data: colorPalette},
+	{Template: "rect.tpl", Output: "go_addon_rect.go", Data: rectTypes},
+	{Template: "matrix.tpl", Output: "go_addon_matrix.go", Data: matrixTypes},
 }
 
 func main() {
-	// --- 1. Setup: Create output directory and file ---
+	// --- 1. Setup: Create output directory ---
 	log.Println("Starting code generation...")
 	outputDir := "./generated"
-	outputFile := outputDir + "/go_addon_vector.go"
 
 	if err := os.MkdirAll(outputDir, os.ModePerm); err != nil {
 		log.Fatalf("Failed to create output directory: %v", err)
 	}
 
-	f, err := os.Create(outputFile)
-	if err != nil {
-		log.Fatalf("Failed to create output file: %v", err)
+	// Every vector type converts to the other types of the same size
+	for i := range vectorTypes {
+		for _, other := range vectorTypes {
+			if other.Name != vectorTypes[i].Name && len(other.Components) == len(vectorTypes[i].Components) {
+				vectorTypes[i].Conversions = append(vectorTypes[i].Conversions, other)
+			}
+		}
 	}
-	defer f.Close()
 
 	// --- 2. Parsing: Load all templates from the ./templates directory ---
 	// We also add a custom "ToLower" function to use in the templates.
@@ -54,11 +119,23 @@ func main() {
 		log.Fatalf("Failed to parse templates: %v", err)
 	}
 
-	// --- 3. Execution: Run the main template and write to the file ---
-	// We execute "main.tpl" and pass our vectorTypes slice as the data.
-	if err := tmpl.Execute(f, vectorTypes); err != nil {
-		log.Fatalf("Failed to execute template: %v", err)
+	// --- 3. Execution: Run the template of each addon and write it to its file ---
+	for _, addon := range addons {
+		outputFile := outputDir + "/" + addon.Output
+		if err := writeAddon(tmpl, addon.Template, outputFile, addon.Data); err != nil {
+			log.Fatalf("Failed to generate %s: %v", outputFile, err)
+		}
+		log.Printf("Successfully generated %s", outputFile)
 	}
+}
+
+// writeAddon executes the named template with data into outputFile.
+func writeAddon(tmpl *template.Template, name string, outputFile string, data any) error {
+	f, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
 
-	log.Printf("Successfully generated %s", outputFile)
+	return tmpl.ExecuteTemplate(f, name, data)
 }
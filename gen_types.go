@@ -142,6 +142,68 @@ func main() {
 						writer.ReturnValue(fmt.Sprintf("%s.%s.cObj", receiverName, unionOverride.GoBaseName))
 					}
 
+					// Create an empty value of the right struct for a type, used to unmarshal
+					// a union value without a C object
+					writer.FunctionHeader(common.FunctionHeader{
+						MethodName: "new" + unionOverride.GoName + "OfType",
+						Parameters: []common.Field{
+							{
+								Name: "eventType",
+								Type: unionOverride.TypeField.Type,
+							},
+						},
+						ReturnType: unionOverride.GoName,
+					})
+					rows = []string{"switch eventType {"}
+					for _, mapper := range unionOverride.Mappers {
+						caseValues := make([]string, len(mapper.CEnumValues))
+						for i, value := range mapper.CEnumValues {
+							caseValues[i] = textcase.PascalCase(converter.StripPrefix(value)) // e.g. "EvtKeyPressed"
+						}
+						rows = append(rows, fmt.Sprintf("case %s:", strings.Join(caseValues, ", ")))
+						rows = append(rows, fmt.Sprintf("\treturn &%s{%s: eventType}", mapper.GoName, unionOverride.TypeField.Name))
+					}
+					rows = append(rows, "default:")
+					rows = append(rows, "\treturn nil")
+					rows = append(rows, "}")
+					writer.FunctionBody(common.FunctionBody{
+						Rows: rows,
+					})
+					writer.VoidReturn()
+
+					// Build the C union from the Go fields, for values made without a C object
+					writer.FunctionHeader(common.FunctionHeader{
+						MethodName: strings.ToLower(unionOverride.GoName[:1]) + unionOverride.GoName[1:] + "ToC", // e.g. "eventToC"
+						Parameters: []common.Field{
+							{
+								Name: "value",
+								Type: unionOverride.GoName,
+							},
+						},
+						ReturnType: fmt.Sprintf("C.%s", rawName),
+					})
+					rows = []string{
+						fmt.Sprintf("var cObj C.%s", rawName),
+						"switch value := value.(type) {",
+					}
+					for _, mapper := range unionOverride.Mappers {
+						rows = append(rows, fmt.Sprintf("case *%s:", mapper.GoName))
+						if converter.IsPhantomStruct(mapper.GoName) != nil {
+							rows = append(rows, fmt.Sprintf("\tC.set_%s_type(&cObj, C.%s(value.%s))", rawName, unionOverride.CTypeField.Type, unionOverride.TypeField.Name))
+						} else {
+							rows = append(rows, fmt.Sprintf("\tC.set_%s_in_%s_union(&cObj, value.ToC())", mapper.CTypeField.Type, rawName))
+						}
+					}
+					rows = append(rows, "}")
+					writer.FunctionBody(common.FunctionBody{
+						Rows: rows,
+					})
+					writer.ReturnValue("cObj")
+
+					if unionOverride.Dispatcher != "" {
+						writeDispatcher(writer, converter, unionOverride)
+					}
+
 					continue
 				}
 
@@ -174,7 +236,10 @@ func main() {
 					if hasWrittenField {
 						funcRes.WriteString(", ")
 					}
-					if _, subOverrideField := converter.GetOverriddenType(field.Type); subOverrideField != nil {
+					if length, cElem, isArray := common.SplitArrayType(cField.Type); isArray {
+						// Arrays have the same layout on both sides, so they are copied as a whole
+						funcRes.WriteString(fmt.Sprintf("%s: *(*[%s]%s)(unsafe.Pointer(&%s.%s))", cField.Name, length, common.TypeConverterToC(cElem), receiverName, field.Name))
+					} else if _, subOverrideField := converter.GetOverriddenType(field.Type); subOverrideField != nil {
 						funcRes.WriteString(fmt.Sprintf("%s: %s.%s.ToC()", cField.Name, receiverName, field.Name))
 					} else if converter.IsKnownGoType(field.Type) && !converter.IsEnum(field.Type) {
 						_, storeAsValue := converter.StoreAsValueOverrides[cField.Type]
@@ -230,7 +295,9 @@ func main() {
 						dereference = "*"
 					}
 
-					if _, subOverrideField := converter.GetOverriddenType(field.Type); subOverrideField != nil {
+					if _, _, isArray := common.SplitArrayType(field.Type); isArray {
+						funcRes.WriteString(fmt.Sprintf("%s: *(*%s)(unsafe.Pointer(&cObj.%s))", field.Name, field.Type, cField.Name))
+					} else if _, subOverrideField := converter.GetOverriddenType(field.Type); subOverrideField != nil {
 						funcRes.WriteString(fmt.Sprintf("%s: %sNew%sFromC(cObj.%s)", field.Name, dereference, subOverrideField.GoName, cField.Name))
 					} else if converter.IsKnownGoType(field.Type) && !converter.IsEnum(field.Type) {
 						funcRes.WriteString(fmt.Sprintf("%s: %sNew%sFromC(cObj.%s)", field.Name, dereference, common.TypeConverterToGo(field.Type), cField.Name))
@@ -391,10 +458,13 @@ func main() {
 				}
 			}
 
+			_, isFlags := converter.FlagEnumOverrides[rawName]
+
 			// Generate enum type:
 			writer.Enum(common.Enum{
 				Name:        goName,
 				Enumerators: enumerators,
+				Flags:       isFlags,
 			})
 		}
 	}
@@ -413,3 +483,127 @@ func main() {
 		log.Fatalf("Failed to write to file: %v", err)
 	}
 }
+
+// writeDispatcher writes a dispatcher for a union, with a typed On<Value> registration
+// method for every enum value of every mapper, e.g. OnKeyPressed(func(*KeyEvent)).
+func writeDispatcher(writer *common.Writer, converter *common.Converter, unionOverride common.UnionOverride) {
+	dispatcher := unionOverride.Dispatcher
+	handlerType := strings.TrimSuffix(dispatcher, "Dispatcher") + "Handler" // e.g. "EventHandler"
+	enumType := unionOverride.TypeField.Type                                // e.g. "EventType"
+
+	writer.Struct(common.Struct{
+		Name: handlerType,
+		Fields: []common.Field{
+			{Name: "dispatcher", Type: common.MakePointerType(dispatcher)},
+			{Name: "eventType", Type: enumType},
+			{Name: "handle", Type: fmt.Sprintf("func(%s)", unionOverride.GoName)},
+			{Name: "consume", Type: "bool"},
+		},
+	})
+
+	handlerReceiver := strings.ToLower(handlerType[:1])
+	writer.ReceiverFunctionHeader(common.ReceiverFunctionHeader{
+		ReceiverName: handlerReceiver,
+		ReceiverType: common.MakePointerType(handlerType),
+		MethodName:   "Consume",
+		Parameters:   []common.Field{{Name: "consume", Type: "bool"}},
+		ReturnType:   common.MakePointerType(handlerType),
+	})
+	writer.FunctionBody(common.FunctionBody{Rows: []string{
+		fmt.Sprintf("%s.consume = consume", handlerReceiver),
+	}})
+	writer.ReturnValue(handlerReceiver)
+
+	writer.ReceiverFunctionHeader(common.ReceiverFunctionHeader{
+		ReceiverName: handlerReceiver,
+		ReceiverType: common.MakePointerType(handlerType),
+		MethodName:   "Unsubscribe",
+		Parameters:   []common.Field{},
+	})
+	writer.FunctionBody(common.FunctionBody{Rows: []string{
+		fmt.Sprintf("handlers := %s.dispatcher.handlers[%s.eventType]", handlerReceiver, handlerReceiver),
+		"for i, handler := range handlers {",
+		fmt.Sprintf("\tif handler == %s {", handlerReceiver),
+		"\t\t// Copy, so a Dispatch in progress keeps iterating the old slice",
+		fmt.Sprintf("\t\t%s.dispatcher.handlers[%s.eventType] = append(handlers[:i:i], handlers[i+1:]...)", handlerReceiver, handlerReceiver),
+		"\t\treturn",
+		"\t}",
+		"}",
+	}})
+	writer.VoidReturn()
+
+	writer.Struct(common.Struct{
+		Name: dispatcher,
+		Fields: []common.Field{
+			{Name: "handlers", Type: fmt.Sprintf("map[%s][]*%s", enumType, handlerType)},
+		},
+	})
+
+	writer.FunctionHeader(common.FunctionHeader{
+		MethodName: "New" + dispatcher,
+		Parameters: []common.Field{},
+		ReturnType: common.MakePointerType(dispatcher),
+	})
+	writer.ReturnValue(fmt.Sprintf("&%s{handlers: make(map[%s][]*%s)}", dispatcher, enumType, handlerType))
+
+	receiverName := strings.ToLower(dispatcher[:1])
+	eventParam := strings.ToLower(unionOverride.GoName[:1]) + unionOverride.GoName[1:] // e.g. "event"
+	writer.ReceiverFunctionHeader(common.ReceiverFunctionHeader{
+		ReceiverName: receiverName,
+		ReceiverType: common.MakePointerType(dispatcher),
+		MethodName:   "Dispatch",
+		Parameters:   []common.Field{{Name: eventParam, Type: unionOverride.GoName}},
+		ReturnType:   "bool",
+	})
+	writer.FunctionBody(common.FunctionBody{Rows: []string{
+		fmt.Sprintf("if %s == nil {", eventParam),
+		"\treturn false",
+		"}",
+		fmt.Sprintf("for _, handler := range %s.handlers[%s.EventType()] {", receiverName, eventParam),
+		fmt.Sprintf("\thandler.handle(%s)", eventParam),
+		"\tif handler.consume {",
+		"\t\treturn true",
+		"\t}",
+		"}",
+	}})
+	writer.ReturnValue("false")
+
+	writer.ReceiverFunctionHeader(common.ReceiverFunctionHeader{
+		ReceiverName: receiverName,
+		ReceiverType: common.MakePointerType(dispatcher),
+		MethodName:   "subscribe",
+		Parameters: []common.Field{
+			{Name: "eventType", Type: enumType},
+			{Name: "handle", Type: fmt.Sprintf("func(%s)", unionOverride.GoName)},
+		},
+		ReturnType: common.MakePointerType(handlerType),
+	})
+	writer.FunctionBody(common.FunctionBody{Rows: []string{
+		"// Created here, so the zero value of the dispatcher is ready to use",
+		fmt.Sprintf("if %s.handlers == nil {", receiverName),
+		fmt.Sprintf("\t%s.handlers = make(map[%s][]*%s)", receiverName, enumType, handlerType),
+		"}",
+		fmt.Sprintf("handler := &%s{dispatcher: %s, eventType: eventType, handle: handle}", handlerType, receiverName),
+		fmt.Sprintf("%s.handlers[eventType] = append(%s.handlers[eventType], handler)", receiverName, receiverName),
+	}})
+	writer.ReturnValue("handler")
+
+	for _, mapper := range unionOverride.Mappers {
+		for _, cEnumValue := range mapper.CEnumValues {
+			enumValue := textcase.PascalCase(converter.StripPrefix(cEnumValue)) // e.g. "EvtKeyPressed"
+			writer.ReceiverFunctionHeader(common.ReceiverFunctionHeader{
+				ReceiverName: receiverName,
+				ReceiverType: common.MakePointerType(dispatcher),
+				MethodName:   "On" + strings.TrimPrefix(enumValue, unionOverride.EnumPrefix),
+				Parameters:   []common.Field{{Name: "handler", Type: fmt.Sprintf("func(*%s)", mapper.GoName)}},
+				ReturnType:   common.MakePointerType(handlerType),
+			})
+			writer.FunctionBody(common.FunctionBody{Rows: []string{
+				fmt.Sprintf("return %s.subscribe(%s, func(%s %s) {", receiverName, enumValue, eventParam, unionOverride.GoName),
+				fmt.Sprintf("\thandler(%s.(*%s))", eventParam, mapper.GoName),
+				"})",
+			}})
+			writer.VoidReturn()
+		}
+	}
+}
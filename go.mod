@@ -3,6 +3,6 @@ module github.com/saffronjam/go-sfml
 go 1.24
 
 require (
-	github.com/goccy/go-yaml v1.18.0 // indirect
-	github.com/golang-cz/textcase v1.2.1 // indirect
+	github.com/goccy/go-yaml v1.18.0
+	github.com/golang-cz/textcase v1.2.1
 )
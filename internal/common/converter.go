@@ -28,6 +28,17 @@ type Converter struct {
 	StoreAsValueOverrides map[string]struct{} // Map cTypes (as translated to GoTypes) that should be stored as values, not pointers, like sfTransform.
 	NilParamOverrides     map[string][]Field  // Map C param names that should accept nil values in Go, like sfShader, used for optional parameters.
 
+	FlagEnumOverrides  map[string]struct{} // Map C enums whose enumerators are bit flags, like sfWindowStyle.
+	FlagParamOverrides map[string][]Field  // Map C sfUint32 params (or "return" for the return value) to the flag enum they carry.
+
+	InterfaceOverrides []InterfaceOverride // Go interfaces generated from the methods shared by wrapper types, like RenderTarget.
+	DispatchOverrides  []DispatchOverride  // Go interfaces for types drawn through a generated interface, like Drawable.
+	OverloadOverrides  []OverloadOverride  // C function families folded into one Go function, like MouseGetPosition.
+
+	MainThreadPrefixes []string // C function prefixes whose wrappers assert they run on the main thread in debug builds.
+
+	NativeTypeOverrides map[string]NativeTypeOverride // Map C value types straight to a Go standard type in every signature, like sfTime to time.Duration.
+
 	SkippedTypes     map[string]struct{}
 	SkippedFunctions map[string]struct{}
 	SkipNameRegex    []string // Regex patterns to skip certain function names
@@ -47,6 +58,13 @@ func NewConverter(typesFile string, functionsFile string) (*Converter, error) {
 				GoName:  "Vector2f",
 				Fields:  []Field{{Name: "X", Type: "float32"}, {Name: "Y", Type: "float32"}},
 				CFields: []Field{{Name: "x", Type: "float"}, {Name: "y", Type: "float"}},
+				ArrayParamOverrides: []ArrayParamOverride{
+					{
+						CFunc:       "sfShader_setVec2UniformArray",
+						CParam:      "vectorArray",
+						CCountParam: "length",
+					},
+				},
 			},
 			"sfVector2u": {
 				GoName:  "Vector2u",
@@ -57,6 +75,37 @@ func NewConverter(typesFile string, functionsFile string) (*Converter, error) {
 				GoName:  "Vector3f",
 				Fields:  []Field{{Name: "X", Type: "float32"}, {Name: "Y", Type: "float32"}, {Name: "Z", Type: "float32"}},
 				CFields: []Field{{Name: "x", Type: "float"}, {Name: "y", Type: "float"}, {Name: "z", Type: "float"}},
+				ArrayParamOverrides: []ArrayParamOverride{
+					{
+						CFunc:       "sfShader_setVec3UniformArray",
+						CParam:      "vectorArray",
+						CCountParam: "length",
+					},
+				},
+			},
+			"sfGlslMat3": {
+				GoName:  "GlslMat3",
+				Fields:  []Field{{Name: "Array", Type: "[9]float32"}},
+				CFields: []Field{{Name: "array", Type: "float[9]"}},
+				ArrayParamOverrides: []ArrayParamOverride{
+					{
+						CFunc:       "sfShader_setMat3UniformArray",
+						CParam:      "matrixArray",
+						CCountParam: "length",
+					},
+				},
+			},
+			"sfGlslMat4": {
+				GoName:  "GlslMat4",
+				Fields:  []Field{{Name: "Array", Type: "[16]float32"}},
+				CFields: []Field{{Name: "array", Type: "float[16]"}},
+				ArrayParamOverrides: []ArrayParamOverride{
+					{
+						CFunc:       "sfShader_setMat4UniformArray",
+						CParam:      "matrixArray",
+						CCountParam: "length",
+					},
+				},
 			},
 			"sfGlslIvec2": {
 				GoName:  "Vector2i",
@@ -102,6 +151,13 @@ func NewConverter(typesFile string, functionsFile string) (*Converter, error) {
 				GoName:  "Vector4f",
 				Fields:  []Field{{Name: "X", Type: "float32"}, {Name: "Y", Type: "float32"}, {Name: "Z", Type: "float32"}, {Name: "W", Type: "float32"}},
 				CFields: []Field{{Name: "x", Type: "float"}, {Name: "y", Type: "float"}, {Name: "z", Type: "float"}, {Name: "w", Type: "float"}},
+				ArrayParamOverrides: []ArrayParamOverride{
+					{
+						CFunc:       "sfShader_setVec4UniformArray",
+						CParam:      "vectorArray",
+						CCountParam: "length",
+					},
+				},
 			},
 			"sfVideoMode": {
 				GoName:  "VideoMode",
@@ -110,7 +166,7 @@ func NewConverter(typesFile string, functionsFile string) (*Converter, error) {
 			},
 			"sfContextSettings": {
 				GoName:  "ContextSettings",
-				Fields:  []Field{{Name: "DepthBits", Type: "uint32"}, {Name: "StencilBits", Type: "uint32"}, {Name: "AntialiasingLevel", Type: "uint32"}, {Name: "MajorVersion", Type: "uint32"}, {Name: "MinorVersion", Type: "uint32"}, {Name: "AttributeFlags", Type: "uint32"}, {Name: "SRgbCapable", Type: "bool"}},
+				Fields:  []Field{{Name: "DepthBits", Type: "uint32"}, {Name: "StencilBits", Type: "uint32"}, {Name: "AntialiasingLevel", Type: "uint32"}, {Name: "MajorVersion", Type: "uint32"}, {Name: "MinorVersion", Type: "uint32"}, {Name: "AttributeFlags", Type: "ContextAttribute"}, {Name: "SRgbCapable", Type: "bool"}},
 				CFields: []Field{{Name: "depthBits", Type: "sfUint32"}, {Name: "stencilBits", Type: "sfUint32"}, {Name: "antialiasingLevel", Type: "sfUint32"}, {Name: "majorVersion", Type: "sfUint32"}, {Name: "minorVersion", Type: "sfUint32"}, {Name: "attributeFlags", Type: "sfUint32"}, {Name: "sRgbCapable", Type: "sfBool"}},
 			},
 			"sfTime": {
@@ -319,6 +375,8 @@ func NewConverter(typesFile string, functionsFile string) (*Converter, error) {
 				GoBaseName: "BaseEvent",
 				TypeField:  Field{Name: "Type", Type: "EventType"},
 				CTypeField: Field{Name: "type", Type: "sfEventType"},
+				Dispatcher: "EventDispatcher",
+				EnumPrefix: "Evt",
 				Mappers: []UnionMapper{
 					// No data events
 					{GoName: "ClosedEvent", CEnumValues: []string{"sfEvtClosed"}},
@@ -387,6 +445,93 @@ func NewConverter(typesFile string, functionsFile string) (*Converter, error) {
 				{Name: "area"},
 			},
 		},
+		FlagEnumOverrides: map[string]struct{}{
+			"sfWindowStyle":      {},
+			"sfTextStyle":        {},
+			"sfContextAttribute": {},
+		},
+		FlagParamOverrides: map[string][]Field{
+			"sfRenderWindow_create":        {{Name: "style", Type: "sfWindowStyle"}},
+			"sfRenderWindow_createUnicode": {{Name: "style", Type: "sfWindowStyle"}},
+			"sfWindow_create":              {{Name: "style", Type: "sfWindowStyle"}},
+			"sfWindow_createUnicode":       {{Name: "style", Type: "sfWindowStyle"}},
+			"sfWindowBase_create":          {{Name: "style", Type: "sfWindowStyle"}},
+			"sfWindowBase_createUnicode":   {{Name: "style", Type: "sfWindowStyle"}},
+			"sfText_setStyle":              {{Name: "style", Type: "sfTextStyle"}},
+			"sfText_getStyle":              {{Name: "return", Type: "sfTextStyle"}},
+		},
+		InterfaceOverrides: []InterfaceOverride{
+			// Limited to what sf::RenderTarget offers, so lifetime, activation and GL state stay on the concrete types.
+			{
+				GoName:       "RenderTarget",
+				Implementers: []string{"RenderWindow", "RenderTexture"},
+				Methods: []string{
+					"Clear",
+					"DrawCircleShape", "DrawConvexShape", "DrawPrimitives", "DrawRectangleShape", "DrawShape",
+					"DrawSprite", "DrawText", "DrawVertexArray", "DrawVertexBuffer", "DrawVertexBufferRange",
+					"DefaultView", "View", "SetView", "Viewport", "Size",
+					"MapCoordsToPixel", "MapPixelToCoords",
+				},
+			},
+			// Only identifies the window types for the overload dispatch, which passes
+			// the C handle of each type on.
+			{GoName: "WindowLike", Implementers: []string{"Window", "RenderWindow", "WindowBase"}, Methods: []string{"SystemHandle"}},
+			// Named Transformer since Transformable is the sfTransformable wrapper.
+			{
+				GoName:       "Transformer",
+				Implementers: []string{"Transformable", "Sprite", "Text", "CircleShape", "RectangleShape", "ConvexShape", "Shape"},
+				Methods:      []string{"Position", "SetPosition", "Move", "Rotation", "SetRotation", "Rotate", "GetScale", "SetScale", "Scale", "Origin", "SetOrigin", "Transform", "InverseTransform"},
+			},
+			{
+				GoName:       "Bounded",
+				Implementers: []string{"Sprite", "Text", "CircleShape", "RectangleShape", "ConvexShape", "Shape"},
+				Methods:      []string{"GlobalBounds", "LocalBounds"},
+			},
+			{
+				GoName:       "Colorable",
+				Implementers: []string{"Text", "CircleShape", "RectangleShape", "ConvexShape", "Shape"},
+				Methods:      []string{"FillColor", "SetFillColor", "OutlineColor", "SetOutlineColor", "OutlineThickness", "SetOutlineThickness"},
+			},
+		},
+		DispatchOverrides: []DispatchOverride{
+			{GoName: "Drawable", Interface: "RenderTarget", Prefix: "Draw", MethodName: "DrawTo", EntryPoint: "Draw"},
+		},
+		OverloadOverrides: []OverloadOverride{
+			{
+				Interface: "WindowLike",
+				Variants:  map[string]string{"sfWindow": "", "sfRenderWindow": "RenderWindow", "sfWindowBase": "WindowBase"},
+			},
+		},
+		// Windowing, input and context calls must happen on the thread that created the window.
+		MainThreadPrefixes: []string{
+			"sfWindow_",
+			"sfWindowBase_",
+			"sfRenderWindow_",
+			"sfContext_",
+			"sfCursor_",
+			"sfClipboard_",
+			"sfKeyboard_",
+			"sfMouse_",
+			"sfTouch_",
+		},
+		NativeTypeOverrides: map[string]NativeTypeOverride{
+			"sfTime": {
+				GoType: "time.Duration",
+				Import: "time",
+				ToC:    "durationToSfTime",
+				FromC:  "sfTimeToDuration",
+				Helpers: []string{
+					`
+func durationToSfTime(d time.Duration) C.sfTime {
+	return C.sfTime{microseconds: C.sfInt64(d.Microseconds())}
+}`,
+					`
+func sfTimeToDuration(t C.sfTime) time.Duration {
+	return time.Duration(t.microseconds) * time.Microsecond
+}`,
+				},
+			},
+		},
 		PrefixMap: map[string]string{
 			"sf": "",
 		},
@@ -394,8 +539,10 @@ func NewConverter(typesFile string, functionsFile string) (*Converter, error) {
 		GoTypesMap:  make(map[string]struct{}),
 		GoEnumsMap:  make(map[string]struct{}), // Map Go‐side enum names to struct{} for quick lookup
 		// Skip native types that are not needed in Go.
-		SkippedTypes:     map[string]struct{}{"sfWindowHandle": {}, "sfBool": {}, "sfChar32": {}, "sfUint8": {}, "sfUint16": {}, "sfUint32": {}, "sfUint64": {}, "sfInt8": {}, "sfInt16": {}, "sfInt32": {}, "sfInt64": {}},
-		SkippedFunctions: map[string]struct{}{"sfShape_create": {}, "sfContext_getFunction": {}, "sfVideoMode_getFullscreenModes": {}, "sfVertexArray_getVertex": {}},
+		SkippedTypes: map[string]struct{}{"sfWindowHandle": {}, "sfBool": {}, "sfChar32": {}, "sfUint8": {}, "sfUint16": {}, "sfUint32": {}, "sfUint64": {}, "sfInt8": {}, "sfInt16": {}, "sfInt32": {}, "sfInt64": {}},
+		SkippedFunctions: map[string]struct{}{"sfShape_create": {}, "sfContext_getFunction": {}, "sfVideoMode_getFullscreenModes": {}, "sfVertexArray_getVertex": {},
+			// sfTime maps to time.Duration, whose constants replace these constructors.
+			"sfSeconds": {}, "sfMilliseconds": {}, "sfMicroseconds": {}},
 		SkipNameRegex: []string{
 			"sfJoystick*",
 			"sfVulkan*",
@@ -510,6 +657,14 @@ func (c *Converter) MapCToGoType(cType string) string {
 		return "string" // Special case for C strings
 	}
 
+	if IsUnicodeStringType(cType) {
+		return "string" // UTF-32 strings are converted with stringToUTF32/utf32ToString
+	}
+
+	if native := c.IsNativeTypeOverride(cType); native != nil {
+		return native.GoType
+	}
+
 	// Strip "const ", "struct ", "*" from cType to get the base.
 	base := strings.ReplaceAll(cType, "const ", "")
 	base = strings.ReplaceAll(base, "struct ", "")
@@ -587,8 +742,19 @@ func (c *Converter) MapCToGoType(cType string) string {
 	return ptr + fallbackType // Return the mapped type with pointer if applicable
 }
 
+// methodNameAcronyms are the fragments of C method names that PascalCase would
+// split wrongly, e.g. "pushGLStates" into "PushGlsTates", with their Go spelling.
+var methodNameAcronyms = map[string]string{
+	"GLStates": "GLStates",
+}
+
 func (c *Converter) TranslateMethodName(cMethodName string) string {
 	pascalCase := textcase.PascalCase(cMethodName)
+	for fragment, goFragment := range methodNameAcronyms {
+		if before, after, found := strings.Cut(cMethodName, fragment); found {
+			pascalCase = textcase.PascalCase(before) + goFragment + textcase.PascalCase(after)
+		}
+	}
 
 	// If contains "Create" and has something before it, prepend "New" and remove "Create".
 	if strings.Contains(pascalCase, "Create") {
@@ -761,3 +927,38 @@ func (c *Converter) IsNilParamOverride(cFunc string, cParamName string) *Field {
 	}
 	return nil
 }
+
+// IsFlagParam checks if a parameter (or "return" for the return value) carries the
+// flags of a flag enum given a C-function name, and returns the enum as the field type.
+func (c *Converter) IsFlagParam(cFunc string, cParamName string) *Field {
+	if fields, ok := c.FlagParamOverrides[cFunc]; ok {
+		for _, f := range fields {
+			if f.Name == cParamName {
+				return &f
+			}
+		}
+	}
+	return nil
+}
+
+// IsNativeTypeOverride checks if a C value type (not a pointer to it) maps straight
+// to a Go standard type, and returns the override.
+func (c *Converter) IsNativeTypeOverride(cType string) *NativeTypeOverride {
+	if IsPointerType(cType) {
+		return nil
+	}
+	if override, ok := c.NativeTypeOverrides[CleanCType(cType)]; ok {
+		return &override
+	}
+	return nil
+}
+
+// IsMainThreadFunction checks if the wrapper of a C function must run on the main thread.
+func (c *Converter) IsMainThreadFunction(cFunc string) bool {
+	for _, prefix := range c.MainThreadPrefixes {
+		if strings.HasPrefix(cFunc, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package common
+
+import "testing"
+
+func TestTranslateMethodName(t *testing.T) {
+	tests := []struct {
+		cName, want string
+	}{
+		{cName: "pushGLStates", want: "PushGLStates"},
+		{cName: "popGLStates", want: "PopGLStates"},
+		{cName: "resetGLStates", want: "ResetGLStates"},
+		{cName: "getPosition", want: "Position"},
+		{cName: "getScale", want: "GetScale"},
+		{cName: "createFromFile", want: "NewFromFile"},
+		{cName: "destroy", want: "Free"},
+		{cName: "setActive", want: "SetActive"},
+	}
+	c := &Converter{}
+	for _, tt := range tests {
+		if got := c.TranslateMethodName(tt.cName); got != tt.want {
+			t.Errorf("TranslateMethodName(%q) = %q, want %q", tt.cName, got, tt.want)
+		}
+	}
+}
@@ -41,6 +41,16 @@ type StructOverride struct {
 	ArrayParamOverrides []ArrayParamOverride
 }
 
+// NativeTypeOverride maps a C value type to a Go standard type, converted by helper
+// functions written into go_types.go.
+type NativeTypeOverride struct {
+	GoType  string   // Go‐side type, e.g. "time.Duration"
+	Import  string   // Package the Go type needs, e.g. "time"
+	ToC     string   // Helper converting the Go value to C, e.g. "durationToSfTime"
+	FromC   string   // Helper converting the C value to Go, e.g. "sfTimeToDuration"
+	Helpers []string // Go source of the ToC and FromC helpers
+}
+
 type UnionMapper struct {
 	CTypeField  Field    // C‐side type field name, e.g. "sfKeyEvent"
 	CEnumValues []string // C‐side enum type name, e.g. "sfEvtClosed"
@@ -55,6 +65,35 @@ type UnionOverride struct {
 	TypeField  Field
 	CTypeField Field
 	Mappers    []UnionMapper
+	Dispatcher string // Go‐side name of the generated dispatcher, e.g. "EventDispatcher", or empty for none
+	EnumPrefix string // Go‐side prefix of the enum values, stripped for handler names, e.g. "Evt"
+}
+
+// InterfaceOverride describes a Go interface generated from the methods that a set
+// of wrapper types have in common.
+type InterfaceOverride struct {
+	GoName       string   // Go‐side interface name, e.g. "RenderTarget"
+	Implementers []string // Go‐side types that must implement it, e.g. "RenderWindow"
+	Methods      []string // Shared methods to include, or empty to include all of them
+}
+
+// DispatchOverride describes an interface for the types drawn by the
+// Draw<Type>(object, states) methods of a generated interface, so that the
+// interface gets a single entry point taking any of them.
+type DispatchOverride struct {
+	GoName     string // Go‐side interface name, e.g. "Drawable"
+	Interface  string // Interface with the Draw<Type> methods, e.g. "RenderTarget"
+	Prefix     string // Method prefix of the per-type methods, e.g. "Draw"
+	MethodName string // Dispatch method added to each type, e.g. "DrawTo"
+	EntryPoint string // Method added to the interface and its implementers, e.g. "Draw"
+}
+
+// OverloadOverride folds C functions that only differ in the type of one parameter,
+// like sfMouse_getPosition and sfMouse_getPositionRenderWindow, into a single Go
+// function taking an interface implemented by all of those types.
+type OverloadOverride struct {
+	Interface string            // Go‐side interface the folded function takes, e.g. "WindowLike"
+	Variants  map[string]string // C function name suffix per C parameter type, e.g. "sfRenderWindow": "RenderWindow"
 }
 
 type Struct struct {
@@ -71,6 +110,7 @@ type Interface struct {
 type Enum struct {
 	Name        string
 	Enumerators []Enumerator
+	Flags       bool // Emit as a uint32 bit set with Has/With/Without/String, e.g. WindowStyle
 }
 
 type FunctionHeader struct {
@@ -63,6 +63,21 @@ func CleanCType(cType string) string {
 	return strings.TrimSpace(t)
 }
 
+// SplitArrayType splits a fixed-size array type, written "[9]float32" in Go or
+// "float[9]" in C, into its length and element type.
+func SplitArrayType(typeName string) (length string, elem string, ok bool) {
+	typeName = strings.TrimSpace(typeName)
+	if strings.HasPrefix(typeName, "[") {
+		length, elem, ok = strings.Cut(typeName[1:], "]")
+		return length, elem, ok
+	}
+	if strings.HasSuffix(typeName, "]") {
+		elem, length, ok = strings.Cut(typeName[:len(typeName)-1], "[")
+		return length, strings.TrimSpace(elem), ok
+	}
+	return "", "", false
+}
+
 // StripPointer removes any pointer symbols from a type name.
 func StripPointer(typeName string) string {
 	typeName = strings.TrimSpace(typeName)
@@ -111,6 +126,17 @@ func IsPointerType(typeName string) bool {
 	return false
 }
 
+// IsUnicodeStringType checks if a C type is a NUL-terminated UTF-32 string, which
+// CSFML passes as sfUint32* (sfChar32* in newer headers).
+func IsUnicodeStringType(cType string) bool {
+	if !IsPointerType(cType) {
+		return false
+	}
+
+	base := CleanCType(cType)
+	return base == "sfUint32" || base == "sfChar32"
+}
+
 // SanitizeFieldNameStr sanitizes a string to be a valid Go identifier.
 // It checks for Go keywords and prepends/appends underscores if necessary.
 func SanitizeFieldNameStr(name string) string {
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"maps"
 	"os"
+	"slices"
 	"strings"
 )
 
@@ -37,7 +39,9 @@ func NewWriter(githubRepo string, converter *Converter, metadataPath string) (*W
 	// Go through all the struct overrides and check if there are any fields called "type".
 	// If so, create a getter and setter for it.
 	var typeHelpers []string
-	for cName, structOverride := range converter.StructOverrides {
+	// Sorted, so the helpers keep their order between runs
+	for _, cName := range slices.Sorted(maps.Keys(converter.StructOverrides)) {
+		structOverride := converter.StructOverrides[cName]
 		for _, cField := range structOverride.CFields {
 
 			if cField.Name == "type" {
@@ -56,7 +60,8 @@ func NewWriter(githubRepo string, converter *Converter, metadataPath string) (*W
 		}
 	}
 
-	for cName, unionOverride := range converter.UnionOverrides {
+	for _, cName := range slices.Sorted(maps.Keys(converter.UnionOverrides)) {
+		unionOverride := converter.UnionOverrides[cName]
 		cField := unionOverride.CTypeField
 		if cField.Name == "type" {
 			// Create a getter and setter for the "type" field
@@ -74,7 +79,8 @@ func NewWriter(githubRepo string, converter *Converter, metadataPath string) (*W
 	}
 
 	// Generate union field accessors for each union override like "get_sfSizeEvent_from_sfEvent_union"
-	for cName, unionOverride := range converter.UnionOverrides {
+	for _, cName := range slices.Sorted(maps.Keys(converter.UnionOverrides)) {
+		unionOverride := converter.UnionOverrides[cName]
 		for _, mapper := range unionOverride.Mappers {
 			if po := converter.IsPhantomStruct(mapper.GoName); po != nil {
 				// Skip phantom structs, they are not real unions
@@ -88,10 +94,14 @@ func NewWriter(githubRepo string, converter *Converter, metadataPath string) (*W
 // static inline %s get_%s_from_%s_union(const %s* a) {
 //     return a->%s;
 // }`, mapper.CTypeField.Type, mapper.CTypeField.Type, cName, cName, mapper.CTypeField.Name))
+			typeHelpers = append(typeHelpers, fmt.Sprintf(`//
+// static inline void set_%s_in_%s_union(%s* a, %s value) {
+//     a->%s = value;
+// }`, mapper.CTypeField.Type, cName, cName, mapper.CTypeField.Type, mapper.CTypeField.Name))
 		}
 	}
 
-	return &Writer{GithubRepo: githubRepo, Converter: converter, Metadata: metadata,
+	writer := &Writer{GithubRepo: githubRepo, Converter: converter, Metadata: metadata,
 		RequiredTypeDefs: []TypeDef{
 			{
 				Name:  "uint",
@@ -112,10 +122,121 @@ func sfBoolToBool(b C.sfBool) bool {
 		return true
 	}
 	return false
+}`,
+			`
+func enumString[T ~int32](value T, values []T, names []string, typeName string) string {
+	for i, v := range values {
+		if v == value {
+			return names[i]
+		}
+	}
+	return typeName + "(" + strconv.FormatInt(int64(value), 10) + ")"
+}`,
+			`
+func parseEnum[T ~int32](s string, values []T, names []string, typeName string) (T, error) {
+	for i, name := range names {
+		if name == s {
+			return values[i], nil
+		}
+	}
+	return 0, fmt.Errorf("sfml: unknown %s %q", typeName, s)
+}`,
+			`
+func enumIsValid[T ~int32](value T, values []T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}`,
+			`
+func flagsString[T ~uint32](value T, flags []T, names []string) string {
+	var set []string
+	rest := value
+	for i, flag := range flags {
+		if flag == 0 && value == 0 {
+			return names[i]
+		}
+		if flag != 0 && flag&(flag-1) == 0 && rest&flag != 0 {
+			set = append(set, names[i])
+			rest &^= flag
+		}
+	}
+	if rest != 0 {
+		set = append(set, "0x"+strconv.FormatUint(uint64(rest), 16))
+	}
+	if len(set) == 0 {
+		return "0"
+	}
+	return strings.Join(set, "|")
+}`,
+			`
+func parseFlags[T ~uint32](s string, flags []T, names []string, typeName string) (T, error) {
+	var value T
+	for _, part := range strings.Split(s, "|") {
+		part = strings.TrimSpace(part)
+		found := false
+		for i, name := range names {
+			if name == part {
+				value |= flags[i]
+				found = true
+				break
+			}
+		}
+		if !found {
+			bits, err := strconv.ParseUint(part, 0, 32)
+			if err != nil {
+				return 0, fmt.Errorf("sfml: unknown %s %q", typeName, part)
+			}
+			value |= T(bits)
+		}
+	}
+	return value, nil
+}`,
+			`
+func flagsIsValid[T ~uint32](value T, flags []T) bool {
+	var known T
+	for _, flag := range flags {
+		known |= flag
+	}
+	return value&^known == 0
+}`,
+			`
+func stringToUTF32(s string) unsafe.Pointer {
+	runes := []rune(s)
+	ptr := C.malloc(C.size_t(len(runes)+1) * C.size_t(unsafe.Sizeof(C.sfUint32(0))))
+	if ptr == nil {
+		panic("C.malloc failed")
+	}
+	buf := unsafe.Slice((*C.sfUint32)(ptr), len(runes)+1)
+	for i, r := range runes {
+		buf[i] = C.sfUint32(r)
+	}
+	buf[len(runes)] = 0
+	return ptr
+}`,
+			`
+func utf32ToString(ptr unsafe.Pointer) string {
+	if ptr == nil {
+		return ""
+	}
+	var runes []rune
+	for p := (*C.sfUint32)(ptr); *p != 0; p = (*C.sfUint32)(unsafe.Add(unsafe.Pointer(p), unsafe.Sizeof(*p))) {
+		runes = append(runes, rune(*p))
+	}
+	return string(runes)
 }`,
 		},
 		RequiredCHelpers: typeHelpers,
-	}, nil
+	}
+
+	// Add the conversion helpers of the native type overrides, sorted for a stable output
+	for _, cName := range slices.Sorted(maps.Keys(converter.NativeTypeOverrides)) {
+		writer.RequiredGoHelpers = append(writer.RequiredGoHelpers, converter.NativeTypeOverrides[cName].Helpers...)
+	}
+
+	return writer, nil
 }
 
 func readMetadata(path string) (*Metadata, error) {
@@ -148,6 +269,7 @@ func (w *Writer) HeaderFunctions() {
 	w.CHelpers()
 	w.acc.WriteString("import \"C\"\n")
 	w.acc.WriteString("import \"unsafe\"\n")
+	w.NativeTypeImports()
 	w.acc.WriteString("\n")
 }
 
@@ -161,11 +283,28 @@ func (w *Writer) HeaderTypes() {
 	w.CLibsSFML()
 	w.CHelpers()
 	w.acc.WriteString("import \"C\"\n")
+	w.acc.WriteString("import \"fmt\"\n")
+	w.acc.WriteString("import \"strconv\"\n")
+	w.acc.WriteString("import \"strings\"\n")
 	w.acc.WriteString("import \"unsafe\"\n")
+	w.NativeTypeImports()
 	w.acc.WriteString("\n")
 	w.GoHelpers()
 }
 
+// NativeTypeImports imports the packages of the Go types that C types are mapped to.
+func (w *Writer) NativeTypeImports() {
+	imports := make(map[string]struct{})
+	for _, override := range w.Converter.NativeTypeOverrides {
+		if override.Import != "" {
+			imports[override.Import] = struct{}{}
+		}
+	}
+	for _, imp := range slices.Sorted(maps.Keys(imports)) {
+		w.acc.WriteString(fmt.Sprintf("import \"%s\"\n", imp))
+	}
+}
+
 func (w *Writer) CTypeDefs() {
 	for _, t := range w.RequiredTypeDefs {
 		w.acc.WriteString(fmt.Sprintf("// typedef %s %s;\n", t.CType, t.Name))
@@ -294,12 +433,142 @@ func (w *Writer) Interface(interfaceDecl Interface) {
 }
 
 func (w *Writer) Enum(enumDecl Enum) {
-	w.acc.WriteString(fmt.Sprintf("type %s int32\n\n", enumDecl.Name))
+	underlying := "int32"
+	if enumDecl.Flags {
+		underlying = "uint32"
+	}
+
+	w.acc.WriteString(fmt.Sprintf("type %s %s\n\n", enumDecl.Name, underlying))
 	w.acc.WriteString(fmt.Sprintf("const (\n"))
 	for _, enumerator := range enumDecl.Enumerators {
 		w.acc.WriteString(fmt.Sprintf("\t%s %s = C.%s\n", enumerator.Name, enumDecl.Name, enumerator.Value))
 	}
 	w.acc.WriteString(")\n\n")
+
+	// Name tables in declaration order, so aliases resolve to the first (current) name.
+	tableName := strings.ToLower(enumDecl.Name[:1]) + enumDecl.Name[1:] // e.g. "keyCode"
+	values := make([]string, len(enumDecl.Enumerators))
+	names := make([]string, len(enumDecl.Enumerators))
+	for i, enumerator := range enumDecl.Enumerators {
+		values[i] = enumerator.Name
+		names[i] = fmt.Sprintf("%q", enumerator.Name)
+	}
+	w.acc.WriteString(fmt.Sprintf("var %sValues = []%s{%s}\n\n", tableName, enumDecl.Name, strings.Join(values, ", ")))
+	w.acc.WriteString(fmt.Sprintf("var %sNames = []string{%s}\n\n", tableName, strings.Join(names, ", ")))
+
+	// Values stop at a "Count" sentinel, which only marks the end of the real values.
+	validCount := len(enumDecl.Enumerators)
+	for i, enumerator := range enumDecl.Enumerators {
+		if strings.HasSuffix(enumerator.Name, "Count") {
+			validCount = i
+			break
+		}
+	}
+
+	receiverName := strings.ToLower(enumDecl.Name[:1])
+	parseHelper, isValidHelper := "parseEnum", "enumIsValid"
+	if enumDecl.Flags {
+		w.flagEnumMethods(enumDecl, receiverName)
+		parseHelper, isValidHelper = "parseFlags", "flagsIsValid"
+	}
+
+	w.ReceiverFunctionHeader(ReceiverFunctionHeader{
+		ReceiverName: receiverName,
+		ReceiverType: enumDecl.Name,
+		MethodName:   "String",
+		Parameters:   []Field{},
+		ReturnType:   "string",
+	})
+	if enumDecl.Flags {
+		w.ReturnValue(fmt.Sprintf("flagsString(%s, %sValues, %sNames)", receiverName, tableName, tableName))
+	} else {
+		w.ReturnValue(fmt.Sprintf("enumString(%s, %sValues, %sNames, %q)", receiverName, tableName, tableName, enumDecl.Name))
+	}
+
+	w.FunctionHeader(FunctionHeader{
+		MethodName: "Parse" + enumDecl.Name,
+		Parameters: []Field{{Name: "s", Type: "string"}},
+		ReturnType: fmt.Sprintf("(%s, error)", enumDecl.Name),
+	})
+	if validCount < len(enumDecl.Enumerators) {
+		// The sentinel is in the tables for String, but isn't a value to parse.
+		// Aliases declared after it still are.
+		w.FunctionBody(FunctionBody{Rows: []string{
+			fmt.Sprintf("if s == %q {", enumDecl.Enumerators[validCount].Name),
+			fmt.Sprintf("\treturn 0, fmt.Errorf(\"sfml: unknown %s %%q\", s)", enumDecl.Name),
+			"}",
+		}})
+	}
+	w.ReturnValue(fmt.Sprintf("%s(s, %sValues, %sNames, %q)", parseHelper, tableName, tableName, enumDecl.Name))
+
+	w.FunctionHeader(FunctionHeader{
+		MethodName: enumDecl.Name + "Values",
+		Parameters: []Field{},
+		ReturnType: "[]" + enumDecl.Name,
+	})
+	w.ReturnValue(fmt.Sprintf("append([]%s(nil), %sValues[:%d]...)", enumDecl.Name, tableName, validCount))
+
+	w.ReceiverFunctionHeader(ReceiverFunctionHeader{
+		ReceiverName: receiverName,
+		ReceiverType: enumDecl.Name,
+		MethodName:   "IsValid",
+		Parameters:   []Field{},
+		ReturnType:   "bool",
+	})
+	w.ReturnValue(fmt.Sprintf("%s(%s, %sValues[:%d])", isValidHelper, receiverName, tableName, validCount))
+
+	w.ReceiverFunctionHeader(ReceiverFunctionHeader{
+		ReceiverName: receiverName,
+		ReceiverType: enumDecl.Name,
+		MethodName:   "MarshalText",
+		Parameters:   []Field{},
+		ReturnType:   "([]byte, error)",
+	})
+	w.FunctionBody(FunctionBody{Rows: []string{
+		fmt.Sprintf("if !%s.IsValid() {", receiverName),
+		fmt.Sprintf("\treturn nil, fmt.Errorf(\"sfml: invalid %s %%d\", %s)", enumDecl.Name, receiverName),
+		"}",
+	}})
+	w.ReturnValue(fmt.Sprintf("[]byte(%s.String()), nil", receiverName))
+
+	w.ReceiverFunctionHeader(ReceiverFunctionHeader{
+		ReceiverName: receiverName,
+		ReceiverType: MakePointerType(enumDecl.Name),
+		MethodName:   "UnmarshalText",
+		Parameters:   []Field{{Name: "text", Type: "[]byte"}},
+		ReturnType:   "error",
+	})
+	w.FunctionBody(FunctionBody{Rows: []string{
+		fmt.Sprintf("value, err := Parse%s(string(text))", enumDecl.Name),
+		"if err != nil {",
+		"\treturn err",
+		"}",
+		fmt.Sprintf("*%s = value", receiverName),
+	}})
+	w.ReturnValue("nil")
+}
+
+// flagEnumMethods writes the bit set helpers for a flag enum.
+func (w *Writer) flagEnumMethods(enumDecl Enum, receiverName string) {
+	flagMethods := []struct {
+		name       string
+		returnType string
+		expr       string
+	}{
+		{"Has", "bool", "%s&flag == flag"},
+		{"With", enumDecl.Name, "%s | flag"},
+		{"Without", enumDecl.Name, "%s &^ flag"},
+	}
+	for _, method := range flagMethods {
+		w.ReceiverFunctionHeader(ReceiverFunctionHeader{
+			ReceiverName: receiverName,
+			ReceiverType: enumDecl.Name,
+			MethodName:   method.name,
+			Parameters:   []Field{{Name: "flag", Type: enumDecl.Name}},
+			ReturnType:   method.returnType,
+		})
+		w.ReturnValue(fmt.Sprintf(method.expr, receiverName))
+	}
 }
 
 func (w *Writer) FunctionHeader(header FunctionHeader) {
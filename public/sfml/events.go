@@ -0,0 +1,97 @@
+package sfml
+
+import (
+	"iter"
+	"time"
+)
+
+// eventPollInterval is how often WaitEventTimeout polls for a new event.
+const eventPollInterval = time.Millisecond
+
+// Events returns an iterator over all pending events. It stops once the queue is
+// empty, so it fits once per frame before drawing:
+//
+//	for event := range window.Events() {
+//		...
+//	}
+func (r *RenderWindow) Events() iter.Seq[Event] {
+	return pendingEvents(r)
+}
+
+// Events returns an iterator over all pending events. It stops once the queue is
+// empty.
+func (w *Window) Events() iter.Seq[Event] {
+	return pendingEvents(w)
+}
+
+// Events returns an iterator over all pending events. It stops once the queue is
+// empty.
+func (w *WindowBase) Events() iter.Seq[Event] {
+	return pendingEvents(w)
+}
+
+// WaitEventTimeout waits up to d for an event. It returns false if none arrived
+// in time.
+func (r *RenderWindow) WaitEventTimeout(d time.Duration) (Event, bool) {
+	return waitEventTimeout(r, d)
+}
+
+// WaitEventTimeout waits up to d for an event. It returns false if none arrived
+// in time.
+func (w *Window) WaitEventTimeout(d time.Duration) (Event, bool) {
+	return waitEventTimeout(w, d)
+}
+
+// WaitEventTimeout waits up to d for an event. It returns false if none arrived
+// in time.
+func (w *WindowBase) WaitEventTimeout(d time.Duration) (Event, bool) {
+	return waitEventTimeout(w, d)
+}
+
+// EventSource is anything events can be polled from, like a window or an
+// EventReplay.
+type EventSource interface {
+	PollEvent() (Event, bool)
+}
+
+// ForwardEvents sends all pending events of source to events, so goroutines can
+// handle them in a select. Call it from the main-thread loop once per frame; it
+// blocks while events is full.
+func ForwardEvents(source EventSource, events chan<- Event) {
+	for event := range pendingEvents(source) {
+		events <- event
+	}
+}
+
+func pendingEvents(source EventSource) iter.Seq[Event] {
+	return func(yield func(Event) bool) {
+		for {
+			event, ok := source.PollEvent()
+			if !ok {
+				return
+			}
+			// Events without a Go mapping, such as joystick events, are skipped.
+			if event == nil {
+				continue
+			}
+			if !yield(event) {
+				return
+			}
+		}
+	}
+}
+
+func waitEventTimeout(source EventSource, d time.Duration) (Event, bool) {
+	deadline := time.Now().Add(d)
+	for {
+		for event := range pendingEvents(source) {
+			return event, true
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, false
+		}
+		time.Sleep(min(remaining, eventPollInterval))
+	}
+}
@@ -0,0 +1,107 @@
+package sfml
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeEventSource reports an empty queue for its first emptyPolls polls, then
+// returns its events in order. A nil event stands for one without a Go mapping.
+type fakeEventSource struct {
+	events     []Event
+	emptyPolls int
+	polls      int
+}
+
+func (s *fakeEventSource) PollEvent() (Event, bool) {
+	s.polls++
+	if s.emptyPolls > 0 {
+		s.emptyPolls--
+		return nil, false
+	}
+	if len(s.events) == 0 {
+		return nil, false
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, true
+}
+
+func TestPendingEvents(t *testing.T) {
+	closed := &ClosedEvent{Type: EvtClosed}
+	key := &KeyEvent{Type: EvtKeyPressed, Code: KeyA}
+	resized := &SizeEvent{Type: EvtResized, Width: 640, Height: 480}
+	source := &fakeEventSource{events: []Event{key, nil, resized, closed}}
+
+	var got []Event
+	for event := range pendingEvents(source) {
+		got = append(got, event)
+		if event == resized {
+			break
+		}
+	}
+	if len(got) != 2 || got[0] != key || got[1] != resized {
+		t.Errorf("first pass got %v, want the key and resize events", got)
+	}
+
+	// Stopping early leaves the rest queued for the next frame
+	got = nil
+	for event := range pendingEvents(source) {
+		got = append(got, event)
+	}
+	if len(got) != 1 || got[0] != closed {
+		t.Errorf("second pass got %v, want the closed event", got)
+	}
+	for event := range pendingEvents(source) {
+		t.Errorf("drained source yielded %v", event)
+	}
+}
+
+func TestWaitEventTimeout(t *testing.T) {
+	key := &KeyEvent{Type: EvtKeyPressed, Code: KeyB}
+
+	source := &fakeEventSource{events: []Event{key}}
+	if event, ok := waitEventTimeout(source, 0); !ok || event != key {
+		t.Errorf("pending event: got %v, %t", event, ok)
+	}
+
+	source = &fakeEventSource{events: []Event{nil, key}, emptyPolls: 3}
+	if event, ok := waitEventTimeout(source, time.Second); !ok || event != key {
+		t.Errorf("late event: got %v, %t", event, ok)
+	}
+
+	source = &fakeEventSource{}
+	const timeout = 20 * time.Millisecond
+	start := time.Now()
+	event, ok := waitEventTimeout(source, timeout)
+	if ok || event != nil {
+		t.Errorf("no event: got %v, %t", event, ok)
+	}
+	if elapsed := time.Since(start); elapsed < timeout {
+		t.Errorf("returned after %v, before the %v timeout", elapsed, timeout)
+	}
+	if source.polls < 2 {
+		t.Errorf("polled %d times while waiting, want several", source.polls)
+	}
+}
+
+func TestForwardEvents(t *testing.T) {
+	closed := &ClosedEvent{Type: EvtClosed}
+	key := &KeyEvent{Type: EvtKeyReleased, Code: KeyC}
+	source := &fakeEventSource{events: []Event{key, nil, closed}}
+
+	events := make(chan Event, 4)
+	ForwardEvents(source, events)
+	close(events)
+
+	var got []Event
+	for event := range events {
+		got = append(got, event)
+	}
+	if len(got) != 2 || got[0] != key || got[1] != closed {
+		t.Errorf("forwarded %v, want the key and closed events", got)
+	}
+
+	// Nothing pending sends nothing
+	ForwardEvents(&fakeEventSource{}, make(chan Event))
+}
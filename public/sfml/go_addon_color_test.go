@@ -0,0 +1,126 @@
+package sfml
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestColorHex(t *testing.T) {
+	tests := []struct {
+		hex     string
+		want    Color
+		format  string
+		wantErr bool
+	}{
+		{hex: "#ff8000", want: Color{R: 255, G: 128, A: 255}, format: "#ff8000"},
+		{hex: "ff800080", want: Color{R: 255, G: 128, A: 128}, format: "#ff800080"},
+		{hex: "#FF8000", want: Color{R: 255, G: 128, A: 255}, format: "#ff8000"},
+		{hex: "#fff", wantErr: true},
+		{hex: "#gg8000", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ColorFromHex(tt.hex)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ColorFromHex(%q) error = %v, want error %v", tt.hex, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ColorFromHex(%q) = %v, want %v", tt.hex, got, tt.want)
+		}
+		if hex := got.Hex(); hex != tt.format {
+			t.Errorf("%v.Hex() = %q, want %q", got, hex, tt.format)
+		}
+	}
+}
+
+func TestColorHsvHsl(t *testing.T) {
+	tests := []struct {
+		c       Color
+		h, s, v float64
+		l       float64
+	}{
+		{c: Color{R: 255, A: 255}, h: 0, s: 1, v: 1, l: 0.5},
+		{c: Color{G: 255, A: 255}, h: 120, s: 1, v: 1, l: 0.5},
+		{c: Color{B: 255, A: 255}, h: 240, s: 1, v: 1, l: 0.5},
+		{c: Color{R: 255, G: 255, B: 255, A: 255}, h: 0, s: 0, v: 1, l: 1},
+		{c: Color{A: 255}, h: 0, s: 0, v: 0, l: 0},
+	}
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+	for _, tt := range tests {
+		if h, s, v := tt.c.Hsv(); !near(h, tt.h) || !near(s, tt.s) || !near(v, tt.v) {
+			t.Errorf("%v.Hsv() = %v, %v, %v, want %v, %v, %v", tt.c, h, s, v, tt.h, tt.s, tt.v)
+		}
+		if got := ColorFromHsv(tt.h, tt.s, tt.v); got != tt.c {
+			t.Errorf("ColorFromHsv(%v, %v, %v) = %v, want %v", tt.h, tt.s, tt.v, got, tt.c)
+		}
+		if got := ColorFromHsl(tt.h, tt.s, tt.l); tt.s != 0 && got != tt.c {
+			t.Errorf("ColorFromHsl(%v, %v, %v) = %v, want %v", tt.h, tt.s, tt.l, got, tt.c)
+		}
+	}
+}
+
+func TestColorGoInterop(t *testing.T) {
+	c := Color{R: 200, G: 100, B: 50, A: 128}
+	if got, want := color.NRGBAModel.Convert(c), (color.NRGBA{R: 200, G: 100, B: 50, A: 128}); got != want {
+		t.Errorf("NRGBAModel.Convert(%v) = %v, want %v", c, got, want)
+	}
+	for _, in := range []color.Color{c, &c, color.NRGBA{R: 200, G: 100, B: 50, A: 128}} {
+		if got := NewColorFromGoColor(in); got != c {
+			t.Errorf("NewColorFromGoColor(%#v) = %v, want %v", in, got, c)
+		}
+	}
+	if got := ColorModel.Convert(color.NRGBA{R: 1, G: 2, B: 3, A: 4}); got != (Color{R: 1, G: 2, B: 3, A: 4}) {
+		t.Errorf("ColorModel.Convert() = %#v, want a Color value", got)
+	}
+}
+
+func TestColorByName(t *testing.T) {
+	for name, want := range ColorPalette {
+		if got, ok := ColorByName(name); !ok || got != want {
+			t.Errorf("ColorByName(%q) = %v, %v, want %v", name, got, ok, want)
+		}
+	}
+	if _, ok := ColorByName("NoSuchColor"); ok {
+		t.Error("ColorByName found a color that isn't in the palette")
+	}
+}
+
+func TestColorPremultiply(t *testing.T) {
+	c := Color{R: 200, G: 100, B: 50, A: 128}
+	p := c.Premultiply()
+	if want := (Color{R: 100, G: 50, B: 25, A: 128}); p != want {
+		t.Errorf("Premultiply() = %v, want %v", p, want)
+	}
+	// Premultiplying loses precision, so the channels come back within one step
+	got := p.Unpremultiply()
+	for _, pair := range [][2]uint8{{got.R, c.R}, {got.G, c.G}, {got.B, c.B}, {got.A, c.A}} {
+		if max(pair[0], pair[1])-min(pair[0], pair[1]) > 1 {
+			t.Errorf("Unpremultiply() = %v, want about %v", got, c)
+			break
+		}
+	}
+	if got := (Color{R: 10, A: 0}).Unpremultiply(); got != (Color{}) {
+		t.Errorf("Unpremultiply() of transparent = %v, want zero", got)
+	}
+}
+
+func TestColorLerpAndLinear(t *testing.T) {
+	black, white := Color{A: 255}, Color{R: 255, G: 255, B: 255, A: 255}
+	if got := black.Lerp(white, 0.5); got != (Color{R: 128, G: 128, B: 128, A: 255}) {
+		t.Errorf("Lerp(0.5) = %v", got)
+	}
+	if got := black.Lerp(white, 1); got != white {
+		t.Errorf("Lerp(1) = %v, want %v", got, white)
+	}
+	gray := Color{R: 128, G: 128, B: 128, A: 200}
+	if got := gray.ToLinear().ToSrgb(); got != gray {
+		t.Errorf("ToLinear().ToSrgb() = %v, want %v", got, gray)
+	}
+	if got := gray.ToLinear(); got.R >= gray.R || got.A != gray.A {
+		t.Errorf("ToLinear() = %v, want darker channels and the same alpha", got)
+	}
+}
@@ -0,0 +1,235 @@
+package sfml
+
+import "testing"
+
+// mat3FromRows builds a GlslMat3 from its rows, as matrices are written on
+// paper.
+func mat3FromRows(rows [3][3]float32) GlslMat3 {
+	var m GlslMat3
+	for row := range rows {
+		for col := range rows[row] {
+			m.Set(row, col, rows[row][col])
+		}
+	}
+	return m
+}
+
+func closeMat3(a, b GlslMat3) bool {
+	for i := range a.Array {
+		if !closeFloat(a.Array[i], b.Array[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func closeMat4(a, b GlslMat4) bool {
+	for i := range a.Array {
+		if !closeFloat(a.Array[i], b.Array[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// transformMat4 returns m * (x, y, z, 1) after the perspective divide.
+func transformMat4(m GlslMat4, x, y, z float32) [3]float32 {
+	var res [4]float32
+	for row := range res {
+		res[row] = m.At(row, 0)*x + m.At(row, 1)*y + m.At(row, 2)*z + m.At(row, 3)
+	}
+	return [3]float32{res[0] / res[3], res[1] / res[3], res[2] / res[3]}
+}
+
+func TestGlslMatLayout(t *testing.T) {
+	var m GlslMat3
+	m.Set(0, 1, 5)
+	m.Set(2, 0, 7)
+	if m.Array[3] != 5 || m.Array[2] != 7 {
+		t.Errorf("Set is not column by column: %v", m.Array)
+	}
+	if m.At(0, 1) != 5 || m.At(1, 0) != 0 {
+		t.Errorf("At(0, 1) = %v, At(1, 0) = %v", m.At(0, 1), m.At(1, 0))
+	}
+	if id := GlslMat4Identity(); id.Array != [16]float32{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1} {
+		t.Errorf("GlslMat4Identity = %v", id.Array)
+	}
+}
+
+func TestGlslMat3Multiply(t *testing.T) {
+	a := mat3FromRows([3][3]float32{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}})
+	b := mat3FromRows([3][3]float32{{2, 0, 1}, {1, 3, 0}, {0, 1, 4}})
+	want := mat3FromRows([3][3]float32{{4, 9, 13}, {13, 21, 28}, {22, 34, 47}})
+	if got := a.Multiply(b); got != want {
+		t.Errorf("Multiply = %v, want %v", got.Array, want.Array)
+	}
+	if got := GlslMat3Identity().Multiply(a); got != a {
+		t.Errorf("identity * a = %v, want %v", got.Array, a.Array)
+	}
+	if got := a.Multiply(GlslMat3Identity()); got != a {
+		t.Errorf("a * identity = %v, want %v", got.Array, a.Array)
+	}
+
+	transposed := a.Transpose()
+	for row := range 3 {
+		for col := range 3 {
+			if transposed.At(row, col) != a.At(col, row) {
+				t.Errorf("Transpose().At(%d, %d) = %v, want %v", row, col, transposed.At(row, col), a.At(col, row))
+			}
+		}
+	}
+	if got := a.Transpose().Transpose(); got != a {
+		t.Errorf("Transpose twice = %v", got.Array)
+	}
+	// (ab)^T = b^T a^T
+	if got, want := a.Multiply(b).Transpose(), b.Transpose().Multiply(a.Transpose()); got != want {
+		t.Errorf("(ab)^T = %v, want %v", got.Array, want.Array)
+	}
+}
+
+func TestGlslMat3Inverse(t *testing.T) {
+	a := mat3FromRows([3][3]float32{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}})
+	inverse, ok := a.Inverse()
+	if !ok {
+		t.Fatal("Inverse failed")
+	}
+	if got := a.Multiply(inverse); !closeMat3(got, GlslMat3Identity()) {
+		t.Errorf("a * Inverse() = %v, want identity", got.Array)
+	}
+	if got := inverse.Multiply(a); !closeMat3(got, GlslMat3Identity()) {
+		t.Errorf("Inverse() * a = %v, want identity", got.Array)
+	}
+
+	singular := mat3FromRows([3][3]float32{{1, 2, 3}, {2, 4, 6}, {0, 1, 1}})
+	if _, ok := singular.Inverse(); ok {
+		t.Error("Inverse of a singular matrix succeeded")
+	}
+}
+
+func TestGlslMat4Inverse(t *testing.T) {
+	tests := []struct {
+		name string
+		m    GlslMat4
+	}{
+		{name: "orthographic", m: GlslMat4Orthographic(0, 800, 600, 0, -1, 1)},
+		{name: "perspective", m: GlslMat4Perspective(60, 1.5, 0.1, 100)},
+		{name: "transform", m: TransformMatrix{2, 1, 5, -1, 3, 7, 0, 0, 1}.ToGlslMat4()},
+	}
+	for _, tt := range tests {
+		inverse, ok := tt.m.Inverse()
+		if !ok {
+			t.Errorf("%s: Inverse failed", tt.name)
+			continue
+		}
+		if got := tt.m.Multiply(inverse); !closeMat4(got, GlslMat4Identity()) {
+			t.Errorf("%s: m * Inverse() = %v, want identity", tt.name, got.Array)
+		}
+		if got := inverse.Multiply(tt.m); !closeMat4(got, GlslMat4Identity()) {
+			t.Errorf("%s: Inverse() * m = %v, want identity", tt.name, got.Array)
+		}
+		if got := tt.m.Transpose().Transpose(); got != tt.m {
+			t.Errorf("%s: Transpose twice = %v", tt.name, got.Array)
+		}
+	}
+
+	var singular GlslMat4
+	singular.Set(0, 0, 1)
+	if _, ok := singular.Inverse(); ok {
+		t.Error("Inverse of a singular matrix succeeded")
+	}
+}
+
+func TestGlslMat4Multiply(t *testing.T) {
+	// other is applied first, like m * other in GLSL
+	scale := TransformMatrix{2, 0, 0, 0, 2, 0, 0, 0, 1}.ToGlslMat4()
+	translate := TransformMatrix{1, 0, 10, 0, 1, 20, 0, 0, 1}.ToGlslMat4()
+	if got := transformMat4(translate.Multiply(scale), 1, 1, 0); got != [3]float32{12, 22, 0} {
+		t.Errorf("translate * scale maps (1, 1) to %v, want (12, 22)", got)
+	}
+	if got := transformMat4(scale.Multiply(translate), 1, 1, 0); got != [3]float32{22, 42, 0} {
+		t.Errorf("scale * translate maps (1, 1) to %v, want (22, 42)", got)
+	}
+}
+
+func TestGlslMat4Projections(t *testing.T) {
+	ortho := GlslMat4Orthographic(0, 800, 600, 0, -1, 1)
+	want := GlslMat4{Array: [16]float32{
+		2.0 / 800, 0, 0, 0,
+		0, -2.0 / 600, 0, 0,
+		0, 0, -1, 0,
+		-1, 1, 0, 1,
+	}}
+	if !closeMat4(ortho, want) {
+		t.Errorf("Orthographic = %v, want %v", ortho.Array, want.Array)
+	}
+	corners := []struct{ in, want [3]float32 }{
+		{in: [3]float32{0, 0, 0}, want: [3]float32{-1, 1, 0}},
+		{in: [3]float32{800, 600, 0}, want: [3]float32{1, -1, 0}},
+		{in: [3]float32{400, 300, 1}, want: [3]float32{0, 0, -1}},
+	}
+	for _, c := range corners {
+		got := transformMat4(ortho, c.in[0], c.in[1], c.in[2])
+		for i := range got {
+			if !closeFloat(got[i], c.want[i]) {
+				t.Errorf("Orthographic maps %v to %v, want %v", c.in, got, c.want)
+				break
+			}
+		}
+	}
+
+	// A 90 degree field of view makes f = 1
+	perspective := GlslMat4Perspective(90, 2, 1, 3)
+	want = GlslMat4{Array: [16]float32{
+		0.5, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, -2, -1,
+		0, 0, -3, 0,
+	}}
+	if !closeMat4(perspective, want) {
+		t.Errorf("Perspective = %v, want %v", perspective.Array, want.Array)
+	}
+	// The near plane maps to -1 and the far one to 1, looking down -Z
+	if got := transformMat4(perspective, 0, 0, -1); !closeFloat(got[2], -1) {
+		t.Errorf("Perspective maps the near plane to z = %v, want -1", got[2])
+	}
+	if got := transformMat4(perspective, 6, 3, -3); !closeFloat(got[0], 1) || !closeFloat(got[1], 1) || !closeFloat(got[2], 1) {
+		t.Errorf("Perspective maps the far top-right corner to %v, want (1, 1, 1)", got)
+	}
+}
+
+// SFML's copyMatrix passes the 4x4 matrix of a Transform to a mat4 uniform as
+// is, and its upper-left 2D block, elements 0, 1, 3, 4, 5, 7, 12, 13 and 15, to
+// a mat3 one.
+func TestToGlslMatMatchesSFML(t *testing.T) {
+	transform := TransformFromMatrix(1, 0, 0, 0, 1, 0, 0, 0, 1)
+	for _, step := range transformSteps {
+		step.sfml(transform)
+
+		var sfmlMatrix [16]float32
+		transform.Matrix(&sfmlMatrix[0])
+		if got := transform.ToGlslMat4(); !closeMat4(got, GlslMat4{Array: sfmlMatrix}) {
+			t.Errorf("after %s: ToGlslMat4() = %v, SFML %v", step.name, got.Array, sfmlMatrix)
+		}
+
+		var want GlslMat3
+		for i, from := range []int{0, 1, 3, 4, 5, 7, 12, 13, 15} {
+			want.Array[i] = sfmlMatrix[from]
+		}
+		if got := transform.ToGlslMat3(); !closeMat3(got, want) {
+			t.Errorf("after %s: ToGlslMat3() = %v, SFML %v", step.name, got.Array, want.Array)
+		}
+
+		// Both transform points like the Transform
+		point := Vector2f{X: 3, Y: -2}
+		wantPoint := *transform.TransformPoint(point)
+		mat3 := transform.ToGlslMat3()
+		x := mat3.At(0, 0)*point.X + mat3.At(0, 1)*point.Y + mat3.At(0, 2)
+		y := mat3.At(1, 0)*point.X + mat3.At(1, 1)*point.Y + mat3.At(1, 2)
+		if !closeVector(Vector2f{X: x, Y: y}, wantPoint) {
+			t.Errorf("after %s: mat3 maps %v to (%v, %v), SFML %v", step.name, point, x, y, wantPoint)
+		}
+		if got := transformMat4(transform.ToGlslMat4(), point.X, point.Y, 0); !closeVector(Vector2f{X: got[0], Y: got[1]}, wantPoint) {
+			t.Errorf("after %s: mat4 maps %v to %v, SFML %v", step.name, point, got, wantPoint)
+		}
+	}
+}
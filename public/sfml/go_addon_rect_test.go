@@ -0,0 +1,136 @@
+package sfml
+
+import "testing"
+
+func TestFloatRectContains(t *testing.T) {
+	tests := []struct {
+		name string
+		rect FloatRect
+		x, y float32
+		want bool
+	}{
+		{name: "inside", rect: FloatRect{Left: 0, Top: 0, Width: 10, Height: 5}, x: 5, y: 2, want: true},
+		{name: "top-left edge", rect: FloatRect{Left: 0, Top: 0, Width: 10, Height: 5}, x: 0, y: 0, want: true},
+		{name: "right edge", rect: FloatRect{Left: 0, Top: 0, Width: 10, Height: 5}, x: 10, y: 2},
+		{name: "bottom edge", rect: FloatRect{Left: 0, Top: 0, Width: 10, Height: 5}, x: 5, y: 5},
+		{name: "outside", rect: FloatRect{Left: 0, Top: 0, Width: 10, Height: 5}, x: -1, y: 2},
+		{name: "negative size", rect: FloatRect{Left: 10, Top: 5, Width: -10, Height: -5}, x: 5, y: 2, want: true},
+		{name: "empty", rect: FloatRect{Left: 3, Top: 3}, x: 3, y: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rect.Contains(tt.x, tt.y); got != tt.want {
+				t.Errorf("%v.Contains(%v, %v) = %v, want %v", tt.rect, tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntRectIntersects(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b IntRect
+		want IntRect
+		ok   bool
+	}{
+		{name: "overlap", a: IntRect{0, 0, 10, 10}, b: IntRect{5, 5, 10, 10}, want: IntRect{5, 5, 5, 5}, ok: true},
+		{name: "inside", a: IntRect{0, 0, 10, 10}, b: IntRect{2, 3, 4, 5}, want: IntRect{2, 3, 4, 5}, ok: true},
+		{name: "touching", a: IntRect{0, 0, 10, 10}, b: IntRect{10, 0, 5, 5}},
+		{name: "apart", a: IntRect{0, 0, 10, 10}, b: IntRect{20, 20, 5, 5}},
+		{name: "negative size", a: IntRect{10, 10, -10, -10}, b: IntRect{5, 5, 10, 10}, want: IntRect{5, 5, 5, 5}, ok: true},
+		{name: "empty", a: IntRect{0, 0, 10, 10}, b: IntRect{5, 5, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.a.Intersection(tt.b)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("%v.Intersection(%v) = %v, %v, want %v, %v", tt.a, tt.b, got, ok, tt.want, tt.ok)
+			}
+			if overlaps := tt.a.Overlaps(tt.b); overlaps != tt.ok {
+				t.Errorf("%v.Overlaps(%v) = %v, want %v", tt.a, tt.b, overlaps, tt.ok)
+			}
+
+			// The pure-Go version must agree with SFML
+			cGot, cOk := tt.a.Intersects(&tt.b)
+			if cOk != tt.ok || (tt.ok && *cGot != tt.want) {
+				t.Errorf("%v.Intersects(%v) = %v, %v, want %v, %v", tt.a, tt.b, *cGot, cOk, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestFloatRectUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b FloatRect
+		want FloatRect
+	}{
+		{name: "apart", a: FloatRect{0, 0, 1, 1}, b: FloatRect{4, 5, 1, 1}, want: FloatRect{0, 0, 5, 6}},
+		{name: "inside", a: FloatRect{0, 0, 10, 10}, b: FloatRect{2, 2, 1, 1}, want: FloatRect{0, 0, 10, 10}},
+		{name: "negative size", a: FloatRect{2, 2, -2, -2}, b: FloatRect{3, 3, 1, 1}, want: FloatRect{0, 0, 4, 4}},
+		{name: "empty", a: FloatRect{0, 0, 2, 2}, b: FloatRect{5, 5, 0, 0}, want: FloatRect{0, 0, 5, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Union(tt.b); got != tt.want {
+				t.Errorf("%v.Union(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := tt.b.Union(tt.a); got != tt.want {
+				t.Errorf("%v.Union(%v) = %v, want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntRectContainsRectAndClip(t *testing.T) {
+	bounds := IntRect{0, 0, 10, 10}
+	tests := []struct {
+		name     string
+		rect     IntRect
+		contains bool
+		clipped  IntRect
+	}{
+		{name: "inside", rect: IntRect{2, 2, 3, 3}, contains: true, clipped: IntRect{2, 2, 3, 3}},
+		{name: "same", rect: bounds, contains: true, clipped: bounds},
+		{name: "crossing", rect: IntRect{8, -2, 5, 5}, clipped: IntRect{8, 0, 2, 3}},
+		{name: "negative size", rect: IntRect{5, 5, -3, -3}, contains: true, clipped: IntRect{2, 2, 3, 3}},
+		{name: "outside", rect: IntRect{20, 20, 5, 5}, clipped: IntRect{10, 10, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bounds.ContainsRect(tt.rect); got != tt.contains {
+				t.Errorf("ContainsRect(%v) = %v, want %v", tt.rect, got, tt.contains)
+			}
+			if got := tt.rect.Clip(bounds); got != tt.clipped {
+				t.Errorf("%v.Clip() = %v, want %v", tt.rect, got, tt.clipped)
+			}
+		})
+	}
+}
+
+func TestRectTransforms(t *testing.T) {
+	r := IntRect{Left: 1, Top: 2, Width: 5, Height: 4}
+	if got, want := r.Center(), (Vector2i{X: 3, Y: 4}); got != want {
+		t.Errorf("Center() = %v, want %v", got, want)
+	}
+	if got, want := r.Inflate(1, -1), (IntRect{0, 3, 7, 2}); got != want {
+		t.Errorf("Inflate(1, -1) = %v, want %v", got, want)
+	}
+	if got, want := r.Translate(Vector2i{X: -1, Y: 3}), (IntRect{0, 5, 5, 4}); got != want {
+		t.Errorf("Translate() = %v, want %v", got, want)
+	}
+	if got, want := r.Corners(), [4]Vector2i{{1, 2}, {6, 2}, {6, 6}, {1, 6}}; got != want {
+		t.Errorf("Corners() = %v, want %v", got, want)
+	}
+	if r != (IntRect{Left: 1, Top: 2, Width: 5, Height: 4}) {
+		t.Errorf("methods modified the receiver: %v", r)
+	}
+
+	f := FloatRect{Left: 0.5, Top: -0.5, Width: 2, Height: 1.2}
+	if got, want := f.ToIntRect(), (IntRect{0, -1, 3, 2}); got != want {
+		t.Errorf("ToIntRect() = %v, want %v", got, want)
+	}
+	if got, want := r.ToFloatRect(), (FloatRect{1, 2, 5, 4}); got != want {
+		t.Errorf("ToFloatRect() = %v, want %v", got, want)
+	}
+}
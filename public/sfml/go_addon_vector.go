@@ -6,6 +6,9 @@ import (
 	"math"
 )
 
+// Vector methods take and return values rather than pointers, so chained math
+// like a.Add(b).MultiplyScalar(2) needs no heap allocations.
+
 
 // ------------------- Vector2f Methods -------------------
 
@@ -19,8 +22,8 @@ Params:
 Returns:
   - A new Vector2f representing the result of the addition.
 */
-func (v *Vector2f) Add(other *Vector2f) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) Add(other Vector2f) Vector2f {
+	return Vector2f{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
 	}
@@ -36,8 +39,8 @@ Params:
 Returns:
   - A new Vector2f representing the result of the subtraction.
 */
-func (v *Vector2f) Subtract(other *Vector2f) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) Subtract(other Vector2f) Vector2f {
+	return Vector2f{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 	}
@@ -53,8 +56,8 @@ Params:
 Returns:
   - A new Vector2f with each component multiplied.
 */
-func (v *Vector2f) Multiply(other *Vector2f) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) Multiply(other Vector2f) Vector2f {
+	return Vector2f{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
 	}
@@ -69,8 +72,8 @@ Params:
 Returns:
   - A new Vector2f with each component scaled.
 */
-func (v *Vector2f) MultiplyScalar(scalar float32) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) MultiplyScalar(scalar float32) Vector2f {
+	return Vector2f{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 	}
@@ -85,8 +88,8 @@ Params:
 Returns:
   - A new Vector2f with each component multiplied by its corresponding scalar.
 */
-func (v *Vector2f) MultiplyScalars(x float32, y float32) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) MultiplyScalars(x float32, y float32) Vector2f {
+	return Vector2f{
 		X: v.X * x,
 		Y: v.Y * y,
 	}
@@ -101,8 +104,8 @@ Params:
 Returns:
   - A new Vector2f with each component divided.
 */
-func (v *Vector2f) Divide(other *Vector2f) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) Divide(other Vector2f) Vector2f {
+	return Vector2f{
 		X: v.X / other.X,
 		Y: v.Y / other.Y,
 	}
@@ -117,8 +120,8 @@ Params:
 Returns:
   - A new Vector2f with each component divided by scalar.
 */
-func (v *Vector2f) DivideScalar(scalar float32) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) DivideScalar(scalar float32) Vector2f {
+	return Vector2f{
 		X: v.X / scalar,
 		Y: v.Y / scalar,
 	}
@@ -133,8 +136,8 @@ Params:
 Returns:
   - A new Vector2f with each component divided.
 */
-func (v *Vector2f) DivideScalars(x float32, y float32) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) DivideScalars(x float32, y float32) Vector2f {
+	return Vector2f{
 		X: v.X / x,
 		Y: v.Y / y,
 	}
@@ -149,90 +152,221 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *Vector2f) Equals(other *Vector2f) bool {
+func (v Vector2f) Equals(other Vector2f) bool {
 	return v.X == other.X && v.Y == other.Y
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *Vector2f) String() string {
+func (v Vector2f) String() string {
 	return fmt.Sprintf("Vector2f(X: %f, Y: %f)", v.X, v.Y)
 }
 
-
-// --- Float-Specific Vector2f Methods ---
-
 /*
 LengthSquared returns the squared magnitude of the vector.
 
 Returns:
   - Sum of squares of components.
 */
-func (v *Vector2f) LengthSquared() float32 {
+func (v Vector2f) LengthSquared() float32 {
 	return v.X*v.X + v.Y*v.Y
 }
 
 /*
-Length returns the Euclidean length (magnitude) of the vector.
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
 
 Returns:
-  - Square root of LengthSquared.
+  - Dot product (scalar).
 */
-func (v *Vector2f) Length() float32 {
-	return float32(math.Sqrt(float64(v.LengthSquared())))
+func (v Vector2f) Dot(other Vector2f) float32 {
+	return v.X*other.X + v.Y*other.Y
 }
 
 /*
-Normalize returns a unit vector pointing in the same direction.
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
 
 Returns:
-  - A normalized vector, or zero vector if original length is 0.
+  - Squared distance (faster if exact distance isn't needed).
 */
-func (v *Vector2f) Normalize() *Vector2f {
-	if l := v.Length(); l != 0 {
-		return v.DivideScalar(l)
+func (v Vector2f) DistanceSquared(other Vector2f) float32 {
+	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y)
+}
+
+/*
+Min returns the component-wise minimum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector2f with the smaller of each component.
+*/
+func (v Vector2f) Min(other Vector2f) Vector2f {
+	return Vector2f{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
 	}
-	return &Vector2f{}
 }
 
 /*
-Dot returns the dot product with another vector.
+Max returns the component-wise maximum of this vector and another.
 
 Params:
-  - other: the vector to dot with.
+  - other: the vector to compare with.
 
 Returns:
-  - Dot product (scalar).
+  - A new Vector2f with the larger of each component.
 */
-func (v *Vector2f) Dot(other *Vector2f) float32 {
-	return v.X*other.X + v.Y*other.Y
+func (v Vector2f) Max(other Vector2f) Vector2f {
+	return Vector2f{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+	}
 }
 
 /*
-Distance returns the Euclidean distance between two vectors.
+ToVector2d converts the vector to a Vector2d.
+
+Returns:
+  - A new Vector2d.
+*/
+func (v Vector2f) ToVector2d() Vector2d {
+	return Vector2d{
+		X: float64(v.X),
+		Y: float64(v.Y),
+	}
+}
+
+/*
+ToVector2i converts the vector to a Vector2i.
+Components are truncated toward zero; use Floor or Round first to round
+differently.
+
+Returns:
+  - A new Vector2i.
+*/
+func (v Vector2f) ToVector2i() Vector2i {
+	return Vector2i{
+		X: int32(v.X),
+		Y: int32(v.Y),
+	}
+}
+
+/*
+ToVector2u converts the vector to a Vector2u.
+Components are truncated toward zero; use Floor or Round first to round
+differently. Negative components become 0, as Go leaves converting
+them to an unsigned integer up to the platform.
+
+Returns:
+  - A new Vector2u.
+*/
+func (v Vector2f) ToVector2u() Vector2u {
+	return Vector2u{
+		X: uint32(max(0, v.X)),
+		Y: uint32(max(0, v.Y)),
+	}
+}
+
+
+// --- Signed Vector2f Methods ---
+
+/*
+Abs returns the vector with the absolute value of each component.
+
+Returns:
+  - A new Vector2f with non-negative components.
+*/
+func (v Vector2f) Abs() Vector2f {
+	return Vector2f{
+		X: float32(math.Abs(float64(v.X))),
+		Y: float32(math.Abs(float64(v.Y))),
+	}
+}
+
+/*
+Negate returns the vector pointing in the opposite direction.
+
+Returns:
+  - A new Vector2f with each component negated.
+*/
+func (v Vector2f) Negate() Vector2f {
+	return Vector2f{
+		X: -v.X,
+		Y: -v.Y,
+	}
+}
+
+/*
+Perpendicular returns the vector rotated by 90 degrees, counterclockwise in a
+Y-up space (clockwise on screen, where Y points down).
+
+Returns:
+  - A new Vector2f perpendicular to this one.
+*/
+func (v Vector2f) Perpendicular() Vector2f {
+	return Vector2f{X: -v.Y, Y: v.X}
+}
+
+/*
+Cross returns the Z component of the 3D cross product of this vector and
+another, both taken with Z = 0. Its sign tells on which side other lies.
 
 Params:
-  - other: the vector to measure distance to.
+  - other: the vector to cross with.
 
 Returns:
-  - Distance as a float.
+  - The scalar cross product.
 */
-func (v *Vector2f) Distance(other *Vector2f) float32 {
-	return float32(math.Sqrt(float64(v.DistanceSquared(other))))
+func (v Vector2f) Cross(other Vector2f) float32 {
+	return v.X*other.Y - v.Y*other.X
 }
 
+
+
+// --- Float-Specific Vector2f Methods ---
+
 /*
-DistanceSquared returns the squared distance between two vectors.
+Length returns the Euclidean length (magnitude) of the vector.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector2f) Length() float32 {
+	return float32(math.Sqrt(float64(v.LengthSquared())))
+}
+
+/*
+Normalize returns a unit vector pointing in the same direction.
+
+Returns:
+  - A normalized vector, or zero vector if original length is 0.
+*/
+func (v Vector2f) Normalize() Vector2f {
+	if l := v.Length(); l != 0 {
+		return v.DivideScalar(l)
+	}
+	return Vector2f{}
+}
+
+/*
+Distance returns the Euclidean distance between two vectors.
 
 Params:
   - other: the vector to measure distance to.
 
 Returns:
-  - Squared distance (faster if exact distance isn't needed).
+  - Distance as a float.
 */
-func (v *Vector2f) DistanceSquared(other *Vector2f) float32 {
-	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y)
+func (v Vector2f) Distance(other Vector2f) float32 {
+	return float32(math.Sqrt(float64(v.DistanceSquared(other))))
 }
 
 /*
@@ -245,8 +379,8 @@ Params:
 Returns:
   - Interpolated vector between this and other.
 */
-func (v *Vector2f) Lerp(other *Vector2f, t float32) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) Lerp(other Vector2f, t float32) Vector2f {
+	return Vector2f{
 		X: v.X + (other.X - v.X)*t,
 		Y: v.Y + (other.Y - v.Y)*t,
 	}
@@ -262,8 +396,8 @@ Params:
 Returns:
   - Clamped vector.
 */
-func (v *Vector2f) Clamp(min, max *Vector2f) *Vector2f {
-	return &Vector2f{
+func (v Vector2f) Clamp(min, max Vector2f) Vector2f {
+	return Vector2f{
 		X: float32(math.Max(float64(min.X), math.Min(float64(max.X), float64(v.X)))),
 		Y: float32(math.Max(float64(min.Y), math.Min(float64(max.Y), float64(v.Y)))),
 	}
@@ -278,9 +412,9 @@ Params:
 Returns:
   - Reflected vector.
 */
-func (v *Vector2f) Reflect(normal *Vector2f) *Vector2f {
+func (v Vector2f) Reflect(normal Vector2f) Vector2f {
 	dot := v.Dot(normal)
-	return &Vector2f{
+	return Vector2f{
 		X: v.X - 2*dot*normal.X,
 		Y: v.Y - 2*dot*normal.Y,
 	}
@@ -295,14 +429,14 @@ Params:
 Returns:
   - Projected vector.
 */
-func (v *Vector2f) Project(other *Vector2f) *Vector2f {
+func (v Vector2f) Project(other Vector2f) Vector2f {
 	dot := v.Dot(other)
 	lengthSquared := other.LengthSquared()
 	if lengthSquared == 0 {
-		return &Vector2f{}
+		return Vector2f{}
 	}
 	scalar := dot / lengthSquared
-	return &Vector2f{
+	return Vector2f{
 		X: other.X * scalar,
 		Y: other.Y * scalar,
 	}
@@ -317,13 +451,81 @@ Params:
 Returns:
   - Rescaled vector, or zero vector if original length is zero.
 */
-func (v *Vector2f) SetLength(length float32) *Vector2f {
+func (v Vector2f) SetLength(length float32) Vector2f {
 	if v.Length() == 0 {
-		return &Vector2f{}
+		return Vector2f{}
 	}
 	return v.Normalize().MultiplyScalar(length)
 }
 
+/*
+Floor rounds each component down.
+
+Returns:
+  - A new Vector2f with whole-number components.
+*/
+func (v Vector2f) Floor() Vector2f {
+	return Vector2f{
+		X: float32(math.Floor(float64(v.X))),
+		Y: float32(math.Floor(float64(v.Y))),
+	}
+}
+
+/*
+Ceil rounds each component up.
+
+Returns:
+  - A new Vector2f with whole-number components.
+*/
+func (v Vector2f) Ceil() Vector2f {
+	return Vector2f{
+		X: float32(math.Ceil(float64(v.X))),
+		Y: float32(math.Ceil(float64(v.Y))),
+	}
+}
+
+/*
+Round rounds each component to the nearest whole number, halves away from zero.
+
+Returns:
+  - A new Vector2f with whole-number components.
+*/
+func (v Vector2f) Round() Vector2f {
+	return Vector2f{
+		X: float32(math.Round(float64(v.X))),
+		Y: float32(math.Round(float64(v.Y))),
+	}
+}
+
+/*
+AngleTo returns the unsigned angle between this vector and another.
+
+Params:
+  - other: the vector to measure the angle to.
+
+Returns:
+  - Angle in degrees in [0, 180], or 0 if either vector has length 0.
+*/
+func (v Vector2f) AngleTo(other Vector2f) float32 {
+	lengths := float64(v.Length()) * float64(other.Length())
+	if lengths == 0 {
+		return 0
+	}
+	cos := math.Max(-1, math.Min(1, float64(v.Dot(other))/lengths))
+	return float32(math.Acos(cos) * (180.0 / math.Pi))
+}
+
+/*
+Angle returns the direction of the vector, measured from the X axis toward the Y
+axis like Rotate.
+
+Returns:
+  - Angle in degrees in (-180, 180].
+*/
+func (v Vector2f) Angle() float32 {
+	return float32(math.Atan2(float64(v.Y), float64(v.X)) * (180.0 / math.Pi))
+}
+
 
 /*
 Rotate rotates a 2D vector by a given angle in degrees.
@@ -334,11 +536,11 @@ Params:
 Returns:
   - Rotated vector.
 */
-func (v *Vector2f) Rotate(angle float32) *Vector2f {
+func (v Vector2f) Rotate(angle float32) Vector2f {
 	radians := angle * (math.Pi / 180.0)
 	cos := float32(math.Cos(float64(radians)))
 	sin := float32(math.Sin(float64(radians)))
-	return &Vector2f{
+	return Vector2f{
 		X: v.X*cos - v.Y*sin,
 		Y: v.X*sin + v.Y*cos,
 	}
@@ -357,8 +559,8 @@ Params:
 Returns:
   - A new Vector2d representing the result of the addition.
 */
-func (v *Vector2d) Add(other *Vector2d) *Vector2d {
-	return &Vector2d{
+func (v Vector2d) Add(other Vector2d) Vector2d {
+	return Vector2d{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
 	}
@@ -374,8 +576,8 @@ Params:
 Returns:
   - A new Vector2d representing the result of the subtraction.
 */
-func (v *Vector2d) Subtract(other *Vector2d) *Vector2d {
-	return &Vector2d{
+func (v Vector2d) Subtract(other Vector2d) Vector2d {
+	return Vector2d{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 	}
@@ -391,8 +593,8 @@ Params:
 Returns:
   - A new Vector2d with each component multiplied.
 */
-func (v *Vector2d) Multiply(other *Vector2d) *Vector2d {
-	return &Vector2d{
+func (v Vector2d) Multiply(other Vector2d) Vector2d {
+	return Vector2d{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
 	}
@@ -407,8 +609,8 @@ Params:
 Returns:
   - A new Vector2d with each component scaled.
 */
-func (v *Vector2d) MultiplyScalar(scalar float64) *Vector2d {
-	return &Vector2d{
+func (v Vector2d) MultiplyScalar(scalar float64) Vector2d {
+	return Vector2d{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 	}
@@ -423,8 +625,8 @@ Params:
 Returns:
   - A new Vector2d with each component multiplied by its corresponding scalar.
 */
-func (v *Vector2d) MultiplyScalars(x float64, y float64) *Vector2d {
-	return &Vector2d{
+func (v Vector2d) MultiplyScalars(x float64, y float64) Vector2d {
+	return Vector2d{
 		X: v.X * x,
 		Y: v.Y * y,
 	}
@@ -439,8 +641,8 @@ Params:
 Returns:
   - A new Vector2d with each component divided.
 */
-func (v *Vector2d) Divide(other *Vector2d) *Vector2d {
-	return &Vector2d{
+func (v Vector2d) Divide(other Vector2d) Vector2d {
+	return Vector2d{
 		X: v.X / other.X,
 		Y: v.Y / other.Y,
 	}
@@ -455,8 +657,8 @@ Params:
 Returns:
   - A new Vector2d with each component divided by scalar.
 */
-func (v *Vector2d) DivideScalar(scalar float64) *Vector2d {
-	return &Vector2d{
+func (v Vector2d) DivideScalar(scalar float64) Vector2d {
+	return Vector2d{
 		X: v.X / scalar,
 		Y: v.Y / scalar,
 	}
@@ -471,8 +673,8 @@ Params:
 Returns:
   - A new Vector2d with each component divided.
 */
-func (v *Vector2d) DivideScalars(x float64, y float64) *Vector2d {
-	return &Vector2d{
+func (v Vector2d) DivideScalars(x float64, y float64) Vector2d {
+	return Vector2d{
 		X: v.X / x,
 		Y: v.Y / y,
 	}
@@ -487,53 +689,27 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *Vector2d) Equals(other *Vector2d) bool {
+func (v Vector2d) Equals(other Vector2d) bool {
 	return v.X == other.X && v.Y == other.Y
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *Vector2d) String() string {
+func (v Vector2d) String() string {
 	return fmt.Sprintf("Vector2d(X: %f, Y: %f)", v.X, v.Y)
 }
 
-
-// --- Float-Specific Vector2d Methods ---
-
 /*
 LengthSquared returns the squared magnitude of the vector.
 
 Returns:
   - Sum of squares of components.
 */
-func (v *Vector2d) LengthSquared() float64 {
+func (v Vector2d) LengthSquared() float64 {
 	return v.X*v.X + v.Y*v.Y
 }
 
-/*
-Length returns the Euclidean length (magnitude) of the vector.
-
-Returns:
-  - Square root of LengthSquared.
-*/
-func (v *Vector2d) Length() float64 {
-	return float64(math.Sqrt(float64(v.LengthSquared())))
-}
-
-/*
-Normalize returns a unit vector pointing in the same direction.
-
-Returns:
-  - A normalized vector, or zero vector if original length is 0.
-*/
-func (v *Vector2d) Normalize() *Vector2d {
-	if l := v.Length(); l != 0 {
-		return v.DivideScalar(l)
-	}
-	return &Vector2d{}
-}
-
 /*
 Dot returns the dot product with another vector.
 
@@ -543,23 +719,10 @@ Params:
 Returns:
   - Dot product (scalar).
 */
-func (v *Vector2d) Dot(other *Vector2d) float64 {
+func (v Vector2d) Dot(other Vector2d) float64 {
 	return v.X*other.X + v.Y*other.Y
 }
 
-/*
-Distance returns the Euclidean distance between two vectors.
-
-Params:
-  - other: the vector to measure distance to.
-
-Returns:
-  - Distance as a float.
-*/
-func (v *Vector2d) Distance(other *Vector2d) float64 {
-	return float64(math.Sqrt(float64(v.DistanceSquared(other))))
-}
-
 /*
 DistanceSquared returns the squared distance between two vectors.
 
@@ -569,151 +732,389 @@ Params:
 Returns:
   - Squared distance (faster if exact distance isn't needed).
 */
-func (v *Vector2d) DistanceSquared(other *Vector2d) float64 {
+func (v Vector2d) DistanceSquared(other Vector2d) float64 {
 	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y)
 }
 
 /*
-Lerp performs linear interpolation toward another vector.
+Min returns the component-wise minimum of this vector and another.
 
 Params:
-  - other: target vector.
-  - t: interpolation factor in [0, 1].
+  - other: the vector to compare with.
 
 Returns:
-  - Interpolated vector between this and other.
+  - A new Vector2d with the smaller of each component.
 */
-func (v *Vector2d) Lerp(other *Vector2d, t float64) *Vector2d {
-	return &Vector2d{
-		X: v.X + (other.X - v.X)*t,
-		Y: v.Y + (other.Y - v.Y)*t,
+func (v Vector2d) Min(other Vector2d) Vector2d {
+	return Vector2d{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
 	}
 }
 
 /*
-Clamp limits each component to the corresponding range.
+Max returns the component-wise maximum of this vector and another.
 
 Params:
-  - min: minimum vector values.
-  - max: maximum vector values.
+  - other: the vector to compare with.
 
 Returns:
-  - Clamped vector.
+  - A new Vector2d with the larger of each component.
 */
-func (v *Vector2d) Clamp(min, max *Vector2d) *Vector2d {
-	return &Vector2d{
-		X: float64(math.Max(float64(min.X), math.Min(float64(max.X), float64(v.X)))),
-		Y: float64(math.Max(float64(min.Y), math.Min(float64(max.Y), float64(v.Y)))),
+func (v Vector2d) Max(other Vector2d) Vector2d {
+	return Vector2d{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
 	}
 }
 
 /*
-Reflect reflects this vector around a surface normal.
-
-Params:
-  - normal: surface normal vector.
+ToVector2f converts the vector to a Vector2f.
 
 Returns:
-  - Reflected vector.
+  - A new Vector2f.
 */
-func (v *Vector2d) Reflect(normal *Vector2d) *Vector2d {
-	dot := v.Dot(normal)
-	return &Vector2d{
-		X: v.X - 2*dot*normal.X,
-		Y: v.Y - 2*dot*normal.Y,
+func (v Vector2d) ToVector2f() Vector2f {
+	return Vector2f{
+		X: float32(v.X),
+		Y: float32(v.Y),
 	}
 }
 
 /*
-Project projects this vector onto another.
-
-Params:
-  - other: vector to project onto.
+ToVector2i converts the vector to a Vector2i.
+Components are truncated toward zero; use Floor or Round first to round
+differently.
 
 Returns:
-  - Projected vector.
+  - A new Vector2i.
 */
-func (v *Vector2d) Project(other *Vector2d) *Vector2d {
-	dot := v.Dot(other)
-	lengthSquared := other.LengthSquared()
-	if lengthSquared == 0 {
-		return &Vector2d{}
-	}
-	scalar := dot / lengthSquared
-	return &Vector2d{
-		X: other.X * scalar,
-		Y: other.Y * scalar,
+func (v Vector2d) ToVector2i() Vector2i {
+	return Vector2i{
+		X: int32(v.X),
+		Y: int32(v.Y),
 	}
 }
 
 /*
-SetLength returns a new vector in the same direction with a given length.
-
-Params:
-  - length: the desired length of the new vector.
+ToVector2u converts the vector to a Vector2u.
+Components are truncated toward zero; use Floor or Round first to round
+differently. Negative components become 0, as Go leaves converting
+them to an unsigned integer up to the platform.
 
 Returns:
-  - Rescaled vector, or zero vector if original length is zero.
+  - A new Vector2u.
 */
-func (v *Vector2d) SetLength(length float64) *Vector2d {
-	if v.Length() == 0 {
-		return &Vector2d{}
+func (v Vector2d) ToVector2u() Vector2u {
+	return Vector2u{
+		X: uint32(max(0, v.X)),
+		Y: uint32(max(0, v.Y)),
 	}
-	return v.Normalize().MultiplyScalar(length)
 }
 
 
-/*
-Rotate rotates a 2D vector by a given angle in degrees.
+// --- Signed Vector2d Methods ---
 
-Params:
-  - angle: angle to rotate in degrees.
+/*
+Abs returns the vector with the absolute value of each component.
 
 Returns:
-  - Rotated vector.
+  - A new Vector2d with non-negative components.
 */
-func (v *Vector2d) Rotate(angle float64) *Vector2d {
-	radians := angle * (math.Pi / 180.0)
-	cos := float64(math.Cos(float64(radians)))
-	sin := float64(math.Sin(float64(radians)))
-	return &Vector2d{
-		X: v.X*cos - v.Y*sin,
-		Y: v.X*sin + v.Y*cos,
+func (v Vector2d) Abs() Vector2d {
+	return Vector2d{
+		X: float64(math.Abs(float64(v.X))),
+		Y: float64(math.Abs(float64(v.Y))),
 	}
 }
 
+/*
+Negate returns the vector pointing in the opposite direction.
 
-// ------------------- Vector2i Methods -------------------
+Returns:
+  - A new Vector2d with each component negated.
+*/
+func (v Vector2d) Negate() Vector2d {
+	return Vector2d{
+		X: -v.X,
+		Y: -v.Y,
+	}
+}
 
 /*
-Add returns a new vector representing the component-wise sum
-of this vector and another.
-
-Params:
-  - other: the vector to add to this one.
+Perpendicular returns the vector rotated by 90 degrees, counterclockwise in a
+Y-up space (clockwise on screen, where Y points down).
 
 Returns:
-  - A new Vector2i representing the result of the addition.
+  - A new Vector2d perpendicular to this one.
 */
-func (v *Vector2i) Add(other *Vector2i) *Vector2i {
-	return &Vector2i{
-		X: v.X + other.X,
-		Y: v.Y + other.Y,
-	}
+func (v Vector2d) Perpendicular() Vector2d {
+	return Vector2d{X: -v.Y, Y: v.X}
 }
 
 /*
-Subtract returns a new vector representing the component-wise difference
-between this vector and another.
+Cross returns the Z component of the 3D cross product of this vector and
+another, both taken with Z = 0. Its sign tells on which side other lies.
 
 Params:
-  - other: the vector to subtract from this one.
+  - other: the vector to cross with.
+
+Returns:
+  - The scalar cross product.
+*/
+func (v Vector2d) Cross(other Vector2d) float64 {
+	return v.X*other.Y - v.Y*other.X
+}
+
+
+
+// --- Float-Specific Vector2d Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector2d) Length() float64 {
+	return float64(math.Sqrt(float64(v.LengthSquared())))
+}
+
+/*
+Normalize returns a unit vector pointing in the same direction.
+
+Returns:
+  - A normalized vector, or zero vector if original length is 0.
+*/
+func (v Vector2d) Normalize() Vector2d {
+	if l := v.Length(); l != 0 {
+		return v.DivideScalar(l)
+	}
+	return Vector2d{}
+}
+
+/*
+Distance returns the Euclidean distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Distance as a float.
+*/
+func (v Vector2d) Distance(other Vector2d) float64 {
+	return float64(math.Sqrt(float64(v.DistanceSquared(other))))
+}
+
+/*
+Lerp performs linear interpolation toward another vector.
+
+Params:
+  - other: target vector.
+  - t: interpolation factor in [0, 1].
+
+Returns:
+  - Interpolated vector between this and other.
+*/
+func (v Vector2d) Lerp(other Vector2d, t float64) Vector2d {
+	return Vector2d{
+		X: v.X + (other.X - v.X)*t,
+		Y: v.Y + (other.Y - v.Y)*t,
+	}
+}
+
+/*
+Clamp limits each component to the corresponding range.
+
+Params:
+  - min: minimum vector values.
+  - max: maximum vector values.
+
+Returns:
+  - Clamped vector.
+*/
+func (v Vector2d) Clamp(min, max Vector2d) Vector2d {
+	return Vector2d{
+		X: float64(math.Max(float64(min.X), math.Min(float64(max.X), float64(v.X)))),
+		Y: float64(math.Max(float64(min.Y), math.Min(float64(max.Y), float64(v.Y)))),
+	}
+}
+
+/*
+Reflect reflects this vector around a surface normal.
+
+Params:
+  - normal: surface normal vector.
+
+Returns:
+  - Reflected vector.
+*/
+func (v Vector2d) Reflect(normal Vector2d) Vector2d {
+	dot := v.Dot(normal)
+	return Vector2d{
+		X: v.X - 2*dot*normal.X,
+		Y: v.Y - 2*dot*normal.Y,
+	}
+}
+
+/*
+Project projects this vector onto another.
+
+Params:
+  - other: vector to project onto.
+
+Returns:
+  - Projected vector.
+*/
+func (v Vector2d) Project(other Vector2d) Vector2d {
+	dot := v.Dot(other)
+	lengthSquared := other.LengthSquared()
+	if lengthSquared == 0 {
+		return Vector2d{}
+	}
+	scalar := dot / lengthSquared
+	return Vector2d{
+		X: other.X * scalar,
+		Y: other.Y * scalar,
+	}
+}
+
+/*
+SetLength returns a new vector in the same direction with a given length.
+
+Params:
+  - length: the desired length of the new vector.
+
+Returns:
+  - Rescaled vector, or zero vector if original length is zero.
+*/
+func (v Vector2d) SetLength(length float64) Vector2d {
+	if v.Length() == 0 {
+		return Vector2d{}
+	}
+	return v.Normalize().MultiplyScalar(length)
+}
+
+/*
+Floor rounds each component down.
+
+Returns:
+  - A new Vector2d with whole-number components.
+*/
+func (v Vector2d) Floor() Vector2d {
+	return Vector2d{
+		X: float64(math.Floor(float64(v.X))),
+		Y: float64(math.Floor(float64(v.Y))),
+	}
+}
+
+/*
+Ceil rounds each component up.
+
+Returns:
+  - A new Vector2d with whole-number components.
+*/
+func (v Vector2d) Ceil() Vector2d {
+	return Vector2d{
+		X: float64(math.Ceil(float64(v.X))),
+		Y: float64(math.Ceil(float64(v.Y))),
+	}
+}
+
+/*
+Round rounds each component to the nearest whole number, halves away from zero.
+
+Returns:
+  - A new Vector2d with whole-number components.
+*/
+func (v Vector2d) Round() Vector2d {
+	return Vector2d{
+		X: float64(math.Round(float64(v.X))),
+		Y: float64(math.Round(float64(v.Y))),
+	}
+}
+
+/*
+AngleTo returns the unsigned angle between this vector and another.
+
+Params:
+  - other: the vector to measure the angle to.
+
+Returns:
+  - Angle in degrees in [0, 180], or 0 if either vector has length 0.
+*/
+func (v Vector2d) AngleTo(other Vector2d) float64 {
+	lengths := float64(v.Length()) * float64(other.Length())
+	if lengths == 0 {
+		return 0
+	}
+	cos := math.Max(-1, math.Min(1, float64(v.Dot(other))/lengths))
+	return float64(math.Acos(cos) * (180.0 / math.Pi))
+}
+
+/*
+Angle returns the direction of the vector, measured from the X axis toward the Y
+axis like Rotate.
+
+Returns:
+  - Angle in degrees in (-180, 180].
+*/
+func (v Vector2d) Angle() float64 {
+	return float64(math.Atan2(float64(v.Y), float64(v.X)) * (180.0 / math.Pi))
+}
+
+
+/*
+Rotate rotates a 2D vector by a given angle in degrees.
+
+Params:
+  - angle: angle to rotate in degrees.
+
+Returns:
+  - Rotated vector.
+*/
+func (v Vector2d) Rotate(angle float64) Vector2d {
+	radians := angle * (math.Pi / 180.0)
+	cos := float64(math.Cos(float64(radians)))
+	sin := float64(math.Sin(float64(radians)))
+	return Vector2d{
+		X: v.X*cos - v.Y*sin,
+		Y: v.X*sin + v.Y*cos,
+	}
+}
+
+
+// ------------------- Vector2i Methods -------------------
+
+/*
+Add returns a new vector representing the component-wise sum
+of this vector and another.
+
+Params:
+  - other: the vector to add to this one.
+
+Returns:
+  - A new Vector2i representing the result of the addition.
+*/
+func (v Vector2i) Add(other Vector2i) Vector2i {
+	return Vector2i{
+		X: v.X + other.X,
+		Y: v.Y + other.Y,
+	}
+}
+
+/*
+Subtract returns a new vector representing the component-wise difference
+between this vector and another.
+
+Params:
+  - other: the vector to subtract from this one.
 
 Returns:
   - A new Vector2i representing the result of the subtraction.
 */
-func (v *Vector2i) Subtract(other *Vector2i) *Vector2i {
-	return &Vector2i{
+func (v Vector2i) Subtract(other Vector2i) Vector2i {
+	return Vector2i{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 	}
@@ -729,8 +1130,8 @@ Params:
 Returns:
   - A new Vector2i with each component multiplied.
 */
-func (v *Vector2i) Multiply(other *Vector2i) *Vector2i {
-	return &Vector2i{
+func (v Vector2i) Multiply(other Vector2i) Vector2i {
+	return Vector2i{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
 	}
@@ -745,100 +1146,1130 @@ Params:
 Returns:
   - A new Vector2i with each component scaled.
 */
-func (v *Vector2i) MultiplyScalar(scalar int32) *Vector2i {
-	return &Vector2i{
+func (v Vector2i) MultiplyScalar(scalar int32) Vector2i {
+	return Vector2i{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 	}
 }
 
 /*
-MultiplyScalars performs component-wise multiplication with individual scalar values.
+MultiplyScalars performs component-wise multiplication with individual scalar values.
+
+Params:
+  - x int32, y int32
+
+Returns:
+  - A new Vector2i with each component multiplied by its corresponding scalar.
+*/
+func (v Vector2i) MultiplyScalars(x int32, y int32) Vector2i {
+	return Vector2i{
+		X: v.X * x,
+		Y: v.Y * y,
+	}
+}
+
+/*
+Divide performs component-wise division of this vector by another.
+
+Params:
+  - other: the vector to divide by.
+
+Returns:
+  - A new Vector2i with each component divided.
+*/
+func (v Vector2i) Divide(other Vector2i) Vector2i {
+	return Vector2i{
+		X: v.X / other.X,
+		Y: v.Y / other.Y,
+	}
+}
+
+/*
+DivideScalar divides each component by a scalar.
+
+Params:
+  - scalar: the scalar divisor.
+
+Returns:
+  - A new Vector2i with each component divided by scalar.
+*/
+func (v Vector2i) DivideScalar(scalar int32) Vector2i {
+	return Vector2i{
+		X: v.X / scalar,
+		Y: v.Y / scalar,
+	}
+}
+
+/*
+DivideScalars divides each component by its corresponding scalar.
+
+Params:
+  - x int32, y int32
+
+Returns:
+  - A new Vector2i with each component divided.
+*/
+func (v Vector2i) DivideScalars(x int32, y int32) Vector2i {
+	return Vector2i{
+		X: v.X / x,
+		Y: v.Y / y,
+	}
+}
+
+/*
+Equals returns true if all components of both vectors are equal.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - Boolean indicating equality.
+*/
+func (v Vector2i) Equals(other Vector2i) bool {
+	return v.X == other.X && v.Y == other.Y
+}
+
+/*
+String returns a formatted string representation of the vector.
+*/
+func (v Vector2i) String() string {
+	return fmt.Sprintf("Vector2i(X: %d, Y: %d)", v.X, v.Y)
+}
+
+/*
+LengthSquared returns the squared magnitude of the vector.
+
+Returns:
+  - Sum of squares of components.
+*/
+func (v Vector2i) LengthSquared() int32 {
+	return v.X*v.X + v.Y*v.Y
+}
+
+/*
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
+
+Returns:
+  - Dot product (scalar).
+*/
+func (v Vector2i) Dot(other Vector2i) int32 {
+	return v.X*other.X + v.Y*other.Y
+}
+
+/*
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Squared distance (faster if exact distance isn't needed).
+*/
+func (v Vector2i) DistanceSquared(other Vector2i) int32 {
+	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y)
+}
+
+/*
+Min returns the component-wise minimum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector2i with the smaller of each component.
+*/
+func (v Vector2i) Min(other Vector2i) Vector2i {
+	return Vector2i{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+	}
+}
+
+/*
+Max returns the component-wise maximum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector2i with the larger of each component.
+*/
+func (v Vector2i) Max(other Vector2i) Vector2i {
+	return Vector2i{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+	}
+}
+
+/*
+ToVector2f converts the vector to a Vector2f.
+
+Returns:
+  - A new Vector2f.
+*/
+func (v Vector2i) ToVector2f() Vector2f {
+	return Vector2f{
+		X: float32(v.X),
+		Y: float32(v.Y),
+	}
+}
+
+/*
+ToVector2d converts the vector to a Vector2d.
+
+Returns:
+  - A new Vector2d.
+*/
+func (v Vector2i) ToVector2d() Vector2d {
+	return Vector2d{
+		X: float64(v.X),
+		Y: float64(v.Y),
+	}
+}
+
+/*
+ToVector2u converts the vector to a Vector2u.
+Components wrap around like Go integer conversions, so negative ones become
+large, e.g. -1 becomes 4294967295.
+
+Returns:
+  - A new Vector2u.
+*/
+func (v Vector2i) ToVector2u() Vector2u {
+	return Vector2u{
+		X: uint32(v.X),
+		Y: uint32(v.Y),
+	}
+}
+
+
+// --- Signed Vector2i Methods ---
+
+/*
+Abs returns the vector with the absolute value of each component.
+
+Returns:
+  - A new Vector2i with non-negative components.
+*/
+func (v Vector2i) Abs() Vector2i {
+	return Vector2i{
+		X: max(v.X, -v.X),
+		Y: max(v.Y, -v.Y),
+	}
+}
+
+/*
+Negate returns the vector pointing in the opposite direction.
+
+Returns:
+  - A new Vector2i with each component negated.
+*/
+func (v Vector2i) Negate() Vector2i {
+	return Vector2i{
+		X: -v.X,
+		Y: -v.Y,
+	}
+}
+
+/*
+Perpendicular returns the vector rotated by 90 degrees, counterclockwise in a
+Y-up space (clockwise on screen, where Y points down).
+
+Returns:
+  - A new Vector2i perpendicular to this one.
+*/
+func (v Vector2i) Perpendicular() Vector2i {
+	return Vector2i{X: -v.Y, Y: v.X}
+}
+
+/*
+Cross returns the Z component of the 3D cross product of this vector and
+another, both taken with Z = 0. Its sign tells on which side other lies.
+
+Params:
+  - other: the vector to cross with.
+
+Returns:
+  - The scalar cross product.
+*/
+func (v Vector2i) Cross(other Vector2i) int32 {
+	return v.X*other.Y - v.Y*other.X
+}
+
+
+// --- Integer Vector2i Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector, computed in
+float64 so it isn't truncated.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector2i) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
+}
+
+/*
+Distance returns the Euclidean distance between two vectors, computed in float64
+so it isn't truncated.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Distance as a float64.
+*/
+func (v Vector2i) Distance(other Vector2i) float64 {
+	return math.Sqrt(float64(v.DistanceSquared(other)))
+}
+
+
+
+// ------------------- Vector2u Methods -------------------
+
+/*
+Add returns a new vector representing the component-wise sum
+of this vector and another.
+
+Params:
+  - other: the vector to add to this one.
+
+Returns:
+  - A new Vector2u representing the result of the addition.
+*/
+func (v Vector2u) Add(other Vector2u) Vector2u {
+	return Vector2u{
+		X: v.X + other.X,
+		Y: v.Y + other.Y,
+	}
+}
+
+/*
+Subtract returns a new vector representing the component-wise difference
+between this vector and another.
+
+Params:
+  - other: the vector to subtract from this one.
+
+Returns:
+  - A new Vector2u representing the result of the subtraction.
+*/
+func (v Vector2u) Subtract(other Vector2u) Vector2u {
+	return Vector2u{
+		X: v.X - other.X,
+		Y: v.Y - other.Y,
+	}
+}
+
+/*
+Multiply returns a new vector with component-wise multiplication
+of this vector and another.
+
+Params:
+  - other: the vector to multiply with.
+
+Returns:
+  - A new Vector2u with each component multiplied.
+*/
+func (v Vector2u) Multiply(other Vector2u) Vector2u {
+	return Vector2u{
+		X: v.X * other.X,
+		Y: v.Y * other.Y,
+	}
+}
+
+/*
+MultiplyScalar multiplies each component of the vector by a scalar.
+
+Params:
+  - scalar: the scalar value to multiply by.
+
+Returns:
+  - A new Vector2u with each component scaled.
+*/
+func (v Vector2u) MultiplyScalar(scalar uint32) Vector2u {
+	return Vector2u{
+		X: v.X * scalar,
+		Y: v.Y * scalar,
+	}
+}
+
+/*
+MultiplyScalars performs component-wise multiplication with individual scalar values.
+
+Params:
+  - x uint32, y uint32
+
+Returns:
+  - A new Vector2u with each component multiplied by its corresponding scalar.
+*/
+func (v Vector2u) MultiplyScalars(x uint32, y uint32) Vector2u {
+	return Vector2u{
+		X: v.X * x,
+		Y: v.Y * y,
+	}
+}
+
+/*
+Divide performs component-wise division of this vector by another.
+
+Params:
+  - other: the vector to divide by.
+
+Returns:
+  - A new Vector2u with each component divided.
+*/
+func (v Vector2u) Divide(other Vector2u) Vector2u {
+	return Vector2u{
+		X: v.X / other.X,
+		Y: v.Y / other.Y,
+	}
+}
+
+/*
+DivideScalar divides each component by a scalar.
+
+Params:
+  - scalar: the scalar divisor.
+
+Returns:
+  - A new Vector2u with each component divided by scalar.
+*/
+func (v Vector2u) DivideScalar(scalar uint32) Vector2u {
+	return Vector2u{
+		X: v.X / scalar,
+		Y: v.Y / scalar,
+	}
+}
+
+/*
+DivideScalars divides each component by its corresponding scalar.
+
+Params:
+  - x uint32, y uint32
+
+Returns:
+  - A new Vector2u with each component divided.
+*/
+func (v Vector2u) DivideScalars(x uint32, y uint32) Vector2u {
+	return Vector2u{
+		X: v.X / x,
+		Y: v.Y / y,
+	}
+}
+
+/*
+Equals returns true if all components of both vectors are equal.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - Boolean indicating equality.
+*/
+func (v Vector2u) Equals(other Vector2u) bool {
+	return v.X == other.X && v.Y == other.Y
+}
+
+/*
+String returns a formatted string representation of the vector.
+*/
+func (v Vector2u) String() string {
+	return fmt.Sprintf("Vector2u(X: %d, Y: %d)", v.X, v.Y)
+}
+
+/*
+LengthSquared returns the squared magnitude of the vector.
+
+Returns:
+  - Sum of squares of components.
+*/
+func (v Vector2u) LengthSquared() uint32 {
+	return v.X*v.X + v.Y*v.Y
+}
+
+/*
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
+
+Returns:
+  - Dot product (scalar).
+*/
+func (v Vector2u) Dot(other Vector2u) uint32 {
+	return v.X*other.X + v.Y*other.Y
+}
+
+/*
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Squared distance (faster if exact distance isn't needed).
+*/
+func (v Vector2u) DistanceSquared(other Vector2u) uint32 {
+	// Subtract the smaller component, so the difference doesn't wrap around
+	x := max(v.X, other.X) - min(v.X, other.X)
+	y := max(v.Y, other.Y) - min(v.Y, other.Y)
+	return x*x + y*y
+}
+
+/*
+Min returns the component-wise minimum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector2u with the smaller of each component.
+*/
+func (v Vector2u) Min(other Vector2u) Vector2u {
+	return Vector2u{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+	}
+}
+
+/*
+Max returns the component-wise maximum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector2u with the larger of each component.
+*/
+func (v Vector2u) Max(other Vector2u) Vector2u {
+	return Vector2u{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+	}
+}
+
+/*
+ToVector2f converts the vector to a Vector2f.
+
+Returns:
+  - A new Vector2f.
+*/
+func (v Vector2u) ToVector2f() Vector2f {
+	return Vector2f{
+		X: float32(v.X),
+		Y: float32(v.Y),
+	}
+}
+
+/*
+ToVector2d converts the vector to a Vector2d.
+
+Returns:
+  - A new Vector2d.
+*/
+func (v Vector2u) ToVector2d() Vector2d {
+	return Vector2d{
+		X: float64(v.X),
+		Y: float64(v.Y),
+	}
+}
+
+/*
+ToVector2i converts the vector to a Vector2i.
+Components wrap around like Go integer conversions, so ones above
+2147483647 become negative.
+
+Returns:
+  - A new Vector2i.
+*/
+func (v Vector2u) ToVector2i() Vector2i {
+	return Vector2i{
+		X: int32(v.X),
+		Y: int32(v.Y),
+	}
+}
+
+
+
+// --- Integer Vector2u Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector, computed in
+float64 so it isn't truncated.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector2u) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
+}
+
+/*
+Distance returns the Euclidean distance between two vectors, computed in float64
+so it isn't truncated.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Distance as a float64.
+*/
+func (v Vector2u) Distance(other Vector2u) float64 {
+	return math.Sqrt(float64(v.DistanceSquared(other)))
+}
+
+
+
+// ------------------- Vector3f Methods -------------------
+
+/*
+Add returns a new vector representing the component-wise sum
+of this vector and another.
+
+Params:
+  - other: the vector to add to this one.
+
+Returns:
+  - A new Vector3f representing the result of the addition.
+*/
+func (v Vector3f) Add(other Vector3f) Vector3f {
+	return Vector3f{
+		X: v.X + other.X,
+		Y: v.Y + other.Y,
+		Z: v.Z + other.Z,
+	}
+}
+
+/*
+Subtract returns a new vector representing the component-wise difference
+between this vector and another.
+
+Params:
+  - other: the vector to subtract from this one.
+
+Returns:
+  - A new Vector3f representing the result of the subtraction.
+*/
+func (v Vector3f) Subtract(other Vector3f) Vector3f {
+	return Vector3f{
+		X: v.X - other.X,
+		Y: v.Y - other.Y,
+		Z: v.Z - other.Z,
+	}
+}
+
+/*
+Multiply returns a new vector with component-wise multiplication
+of this vector and another.
+
+Params:
+  - other: the vector to multiply with.
+
+Returns:
+  - A new Vector3f with each component multiplied.
+*/
+func (v Vector3f) Multiply(other Vector3f) Vector3f {
+	return Vector3f{
+		X: v.X * other.X,
+		Y: v.Y * other.Y,
+		Z: v.Z * other.Z,
+	}
+}
+
+/*
+MultiplyScalar multiplies each component of the vector by a scalar.
+
+Params:
+  - scalar: the scalar value to multiply by.
+
+Returns:
+  - A new Vector3f with each component scaled.
+*/
+func (v Vector3f) MultiplyScalar(scalar float32) Vector3f {
+	return Vector3f{
+		X: v.X * scalar,
+		Y: v.Y * scalar,
+		Z: v.Z * scalar,
+	}
+}
+
+/*
+MultiplyScalars performs component-wise multiplication with individual scalar values.
+
+Params:
+  - x float32, y float32, z float32
+
+Returns:
+  - A new Vector3f with each component multiplied by its corresponding scalar.
+*/
+func (v Vector3f) MultiplyScalars(x float32, y float32, z float32) Vector3f {
+	return Vector3f{
+		X: v.X * x,
+		Y: v.Y * y,
+		Z: v.Z * z,
+	}
+}
+
+/*
+Divide performs component-wise division of this vector by another.
+
+Params:
+  - other: the vector to divide by.
+
+Returns:
+  - A new Vector3f with each component divided.
+*/
+func (v Vector3f) Divide(other Vector3f) Vector3f {
+	return Vector3f{
+		X: v.X / other.X,
+		Y: v.Y / other.Y,
+		Z: v.Z / other.Z,
+	}
+}
+
+/*
+DivideScalar divides each component by a scalar.
+
+Params:
+  - scalar: the scalar divisor.
+
+Returns:
+  - A new Vector3f with each component divided by scalar.
+*/
+func (v Vector3f) DivideScalar(scalar float32) Vector3f {
+	return Vector3f{
+		X: v.X / scalar,
+		Y: v.Y / scalar,
+		Z: v.Z / scalar,
+	}
+}
+
+/*
+DivideScalars divides each component by its corresponding scalar.
+
+Params:
+  - x float32, y float32, z float32
+
+Returns:
+  - A new Vector3f with each component divided.
+*/
+func (v Vector3f) DivideScalars(x float32, y float32, z float32) Vector3f {
+	return Vector3f{
+		X: v.X / x,
+		Y: v.Y / y,
+		Z: v.Z / z,
+	}
+}
+
+/*
+Equals returns true if all components of both vectors are equal.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - Boolean indicating equality.
+*/
+func (v Vector3f) Equals(other Vector3f) bool {
+	return v.X == other.X && v.Y == other.Y && v.Z == other.Z
+}
+
+/*
+String returns a formatted string representation of the vector.
+*/
+func (v Vector3f) String() string {
+	return fmt.Sprintf("Vector3f(X: %f, Y: %f, Z: %f)", v.X, v.Y, v.Z)
+}
+
+/*
+LengthSquared returns the squared magnitude of the vector.
+
+Returns:
+  - Sum of squares of components.
+*/
+func (v Vector3f) LengthSquared() float32 {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+}
+
+/*
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
+
+Returns:
+  - Dot product (scalar).
+*/
+func (v Vector3f) Dot(other Vector3f) float32 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
+}
+
+/*
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Squared distance (faster if exact distance isn't needed).
+*/
+func (v Vector3f) DistanceSquared(other Vector3f) float32 {
+	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z)
+}
+
+/*
+Min returns the component-wise minimum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector3f with the smaller of each component.
+*/
+func (v Vector3f) Min(other Vector3f) Vector3f {
+	return Vector3f{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+		Z: min(v.Z, other.Z),
+	}
+}
+
+/*
+Max returns the component-wise maximum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector3f with the larger of each component.
+*/
+func (v Vector3f) Max(other Vector3f) Vector3f {
+	return Vector3f{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+		Z: max(v.Z, other.Z),
+	}
+}
+
+/*
+ToVector3d converts the vector to a Vector3d.
+
+Returns:
+  - A new Vector3d.
+*/
+func (v Vector3f) ToVector3d() Vector3d {
+	return Vector3d{
+		X: float64(v.X),
+		Y: float64(v.Y),
+		Z: float64(v.Z),
+	}
+}
+
+/*
+ToVector3i converts the vector to a Vector3i.
+Components are truncated toward zero; use Floor or Round first to round
+differently.
+
+Returns:
+  - A new Vector3i.
+*/
+func (v Vector3f) ToVector3i() Vector3i {
+	return Vector3i{
+		X: int32(v.X),
+		Y: int32(v.Y),
+		Z: int32(v.Z),
+	}
+}
+
+/*
+ToVector3u converts the vector to a Vector3u.
+Components are truncated toward zero; use Floor or Round first to round
+differently. Negative components become 0, as Go leaves converting
+them to an unsigned integer up to the platform.
+
+Returns:
+  - A new Vector3u.
+*/
+func (v Vector3f) ToVector3u() Vector3u {
+	return Vector3u{
+		X: uint32(max(0, v.X)),
+		Y: uint32(max(0, v.Y)),
+		Z: uint32(max(0, v.Z)),
+	}
+}
+
+/*
+XY returns the X and Y components as a 2D vector.
+*/
+func (v Vector3f) XY() Vector2f {
+	return Vector2f{X: v.X, Y: v.Y}
+}
+
+/*
+XZ returns the X and Z components as a 2D vector.
+*/
+func (v Vector3f) XZ() Vector2f {
+	return Vector2f{X: v.X, Y: v.Z}
+}
+
+/*
+YZ returns the Y and Z components as a 2D vector.
+*/
+func (v Vector3f) YZ() Vector2f {
+	return Vector2f{X: v.Y, Y: v.Z}
+}
+
+
+// --- Signed Vector3f Methods ---
+
+/*
+Abs returns the vector with the absolute value of each component.
+
+Returns:
+  - A new Vector3f with non-negative components.
+*/
+func (v Vector3f) Abs() Vector3f {
+	return Vector3f{
+		X: float32(math.Abs(float64(v.X))),
+		Y: float32(math.Abs(float64(v.Y))),
+		Z: float32(math.Abs(float64(v.Z))),
+	}
+}
+
+/*
+Negate returns the vector pointing in the opposite direction.
+
+Returns:
+  - A new Vector3f with each component negated.
+*/
+func (v Vector3f) Negate() Vector3f {
+	return Vector3f{
+		X: -v.X,
+		Y: -v.Y,
+		Z: -v.Z,
+	}
+}
+
+/*
+Cross returns the cross product of this vector and another.
+
+Params:
+  - other: the vector to cross with.
+
+Returns:
+  - A new Vector3f perpendicular to both vectors.
+*/
+func (v Vector3f) Cross(other Vector3f) Vector3f {
+	return Vector3f{
+		X: v.Y*other.Z - v.Z*other.Y,
+		Y: v.Z*other.X - v.X*other.Z,
+		Z: v.X*other.Y - v.Y*other.X,
+	}
+}
+
+
+
+// --- Float-Specific Vector3f Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector3f) Length() float32 {
+	return float32(math.Sqrt(float64(v.LengthSquared())))
+}
+
+/*
+Normalize returns a unit vector pointing in the same direction.
+
+Returns:
+  - A normalized vector, or zero vector if original length is 0.
+*/
+func (v Vector3f) Normalize() Vector3f {
+	if l := v.Length(); l != 0 {
+		return v.DivideScalar(l)
+	}
+	return Vector3f{}
+}
+
+/*
+Distance returns the Euclidean distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Distance as a float.
+*/
+func (v Vector3f) Distance(other Vector3f) float32 {
+	return float32(math.Sqrt(float64(v.DistanceSquared(other))))
+}
+
+/*
+Lerp performs linear interpolation toward another vector.
+
+Params:
+  - other: target vector.
+  - t: interpolation factor in [0, 1].
+
+Returns:
+  - Interpolated vector between this and other.
+*/
+func (v Vector3f) Lerp(other Vector3f, t float32) Vector3f {
+	return Vector3f{
+		X: v.X + (other.X - v.X)*t,
+		Y: v.Y + (other.Y - v.Y)*t,
+		Z: v.Z + (other.Z - v.Z)*t,
+	}
+}
+
+/*
+Clamp limits each component to the corresponding range.
+
+Params:
+  - min: minimum vector values.
+  - max: maximum vector values.
+
+Returns:
+  - Clamped vector.
+*/
+func (v Vector3f) Clamp(min, max Vector3f) Vector3f {
+	return Vector3f{
+		X: float32(math.Max(float64(min.X), math.Min(float64(max.X), float64(v.X)))),
+		Y: float32(math.Max(float64(min.Y), math.Min(float64(max.Y), float64(v.Y)))),
+		Z: float32(math.Max(float64(min.Z), math.Min(float64(max.Z), float64(v.Z)))),
+	}
+}
+
+/*
+Reflect reflects this vector around a surface normal.
+
+Params:
+  - normal: surface normal vector.
+
+Returns:
+  - Reflected vector.
+*/
+func (v Vector3f) Reflect(normal Vector3f) Vector3f {
+	dot := v.Dot(normal)
+	return Vector3f{
+		X: v.X - 2*dot*normal.X,
+		Y: v.Y - 2*dot*normal.Y,
+		Z: v.Z - 2*dot*normal.Z,
+	}
+}
+
+/*
+Project projects this vector onto another.
+
+Params:
+  - other: vector to project onto.
+
+Returns:
+  - Projected vector.
+*/
+func (v Vector3f) Project(other Vector3f) Vector3f {
+	dot := v.Dot(other)
+	lengthSquared := other.LengthSquared()
+	if lengthSquared == 0 {
+		return Vector3f{}
+	}
+	scalar := dot / lengthSquared
+	return Vector3f{
+		X: other.X * scalar,
+		Y: other.Y * scalar,
+		Z: other.Z * scalar,
+	}
+}
+
+/*
+SetLength returns a new vector in the same direction with a given length.
 
 Params:
-  - x int32, y int32
+  - length: the desired length of the new vector.
 
 Returns:
-  - A new Vector2i with each component multiplied by its corresponding scalar.
+  - Rescaled vector, or zero vector if original length is zero.
 */
-func (v *Vector2i) MultiplyScalars(x int32, y int32) *Vector2i {
-	return &Vector2i{
-		X: v.X * x,
-		Y: v.Y * y,
+func (v Vector3f) SetLength(length float32) Vector3f {
+	if v.Length() == 0 {
+		return Vector3f{}
 	}
+	return v.Normalize().MultiplyScalar(length)
 }
 
 /*
-Divide performs component-wise division of this vector by another.
-
-Params:
-  - other: the vector to divide by.
+Floor rounds each component down.
 
 Returns:
-  - A new Vector2i with each component divided.
+  - A new Vector3f with whole-number components.
 */
-func (v *Vector2i) Divide(other *Vector2i) *Vector2i {
-	return &Vector2i{
-		X: v.X / other.X,
-		Y: v.Y / other.Y,
+func (v Vector3f) Floor() Vector3f {
+	return Vector3f{
+		X: float32(math.Floor(float64(v.X))),
+		Y: float32(math.Floor(float64(v.Y))),
+		Z: float32(math.Floor(float64(v.Z))),
 	}
 }
 
 /*
-DivideScalar divides each component by a scalar.
-
-Params:
-  - scalar: the scalar divisor.
+Ceil rounds each component up.
 
 Returns:
-  - A new Vector2i with each component divided by scalar.
+  - A new Vector3f with whole-number components.
 */
-func (v *Vector2i) DivideScalar(scalar int32) *Vector2i {
-	return &Vector2i{
-		X: v.X / scalar,
-		Y: v.Y / scalar,
+func (v Vector3f) Ceil() Vector3f {
+	return Vector3f{
+		X: float32(math.Ceil(float64(v.X))),
+		Y: float32(math.Ceil(float64(v.Y))),
+		Z: float32(math.Ceil(float64(v.Z))),
 	}
 }
 
 /*
-DivideScalars divides each component by its corresponding scalar.
-
-Params:
-  - x int32, y int32
+Round rounds each component to the nearest whole number, halves away from zero.
 
 Returns:
-  - A new Vector2i with each component divided.
+  - A new Vector3f with whole-number components.
 */
-func (v *Vector2i) DivideScalars(x int32, y int32) *Vector2i {
-	return &Vector2i{
-		X: v.X / x,
-		Y: v.Y / y,
+func (v Vector3f) Round() Vector3f {
+	return Vector3f{
+		X: float32(math.Round(float64(v.X))),
+		Y: float32(math.Round(float64(v.Y))),
+		Z: float32(math.Round(float64(v.Z))),
 	}
 }
 
 /*
-Equals returns true if all components of both vectors are equal.
+AngleTo returns the unsigned angle between this vector and another.
 
 Params:
-  - other: the vector to compare with.
+  - other: the vector to measure the angle to.
 
 Returns:
-  - Boolean indicating equality.
+  - Angle in degrees in [0, 180], or 0 if either vector has length 0.
 */
-func (v *Vector2i) Equals(other *Vector2i) bool {
-	return v.X == other.X && v.Y == other.Y
+func (v Vector3f) AngleTo(other Vector3f) float32 {
+	lengths := float64(v.Length()) * float64(other.Length())
+	if lengths == 0 {
+		return 0
+	}
+	cos := math.Max(-1, math.Min(1, float64(v.Dot(other))/lengths))
+	return float32(math.Acos(cos) * (180.0 / math.Pi))
 }
 
-/*
-String returns a formatted string representation of the vector.
-*/
-func (v *Vector2i) String() string {
-	return fmt.Sprintf("Vector2i(X: %d, Y: %d)", v.X, v.Y)
-}
 
 
 
-// ------------------- Vector2u Methods -------------------
+// ------------------- Vector3d Methods -------------------
 
 /*
 Add returns a new vector representing the component-wise sum
@@ -848,12 +2279,13 @@ Params:
   - other: the vector to add to this one.
 
 Returns:
-  - A new Vector2u representing the result of the addition.
+  - A new Vector3d representing the result of the addition.
 */
-func (v *Vector2u) Add(other *Vector2u) *Vector2u {
-	return &Vector2u{
+func (v Vector3d) Add(other Vector3d) Vector3d {
+	return Vector3d{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
+		Z: v.Z + other.Z,
 	}
 }
 
@@ -865,12 +2297,13 @@ Params:
   - other: the vector to subtract from this one.
 
 Returns:
-  - A new Vector2u representing the result of the subtraction.
+  - A new Vector3d representing the result of the subtraction.
 */
-func (v *Vector2u) Subtract(other *Vector2u) *Vector2u {
-	return &Vector2u{
+func (v Vector3d) Subtract(other Vector3d) Vector3d {
+	return Vector3d{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
+		Z: v.Z - other.Z,
 	}
 }
 
@@ -882,12 +2315,13 @@ Params:
   - other: the vector to multiply with.
 
 Returns:
-  - A new Vector2u with each component multiplied.
+  - A new Vector3d with each component multiplied.
 */
-func (v *Vector2u) Multiply(other *Vector2u) *Vector2u {
-	return &Vector2u{
+func (v Vector3d) Multiply(other Vector3d) Vector3d {
+	return Vector3d{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
+		Z: v.Z * other.Z,
 	}
 }
 
@@ -898,12 +2332,13 @@ Params:
   - scalar: the scalar value to multiply by.
 
 Returns:
-  - A new Vector2u with each component scaled.
+  - A new Vector3d with each component scaled.
 */
-func (v *Vector2u) MultiplyScalar(scalar uint32) *Vector2u {
-	return &Vector2u{
+func (v Vector3d) MultiplyScalar(scalar float64) Vector3d {
+	return Vector3d{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
+		Z: v.Z * scalar,
 	}
 }
 
@@ -911,15 +2346,16 @@ func (v *Vector2u) MultiplyScalar(scalar uint32) *Vector2u {
 MultiplyScalars performs component-wise multiplication with individual scalar values.
 
 Params:
-  - x uint32, y uint32
+  - x float64, y float64, z float64
 
 Returns:
-  - A new Vector2u with each component multiplied by its corresponding scalar.
+  - A new Vector3d with each component multiplied by its corresponding scalar.
 */
-func (v *Vector2u) MultiplyScalars(x uint32, y uint32) *Vector2u {
-	return &Vector2u{
+func (v Vector3d) MultiplyScalars(x float64, y float64, z float64) Vector3d {
+	return Vector3d{
 		X: v.X * x,
 		Y: v.Y * y,
+		Z: v.Z * z,
 	}
 }
 
@@ -930,12 +2366,13 @@ Params:
   - other: the vector to divide by.
 
 Returns:
-  - A new Vector2u with each component divided.
+  - A new Vector3d with each component divided.
 */
-func (v *Vector2u) Divide(other *Vector2u) *Vector2u {
-	return &Vector2u{
+func (v Vector3d) Divide(other Vector3d) Vector3d {
+	return Vector3d{
 		X: v.X / other.X,
 		Y: v.Y / other.Y,
+		Z: v.Z / other.Z,
 	}
 }
 
@@ -946,12 +2383,13 @@ Params:
   - scalar: the scalar divisor.
 
 Returns:
-  - A new Vector2u with each component divided by scalar.
+  - A new Vector3d with each component divided by scalar.
 */
-func (v *Vector2u) DivideScalar(scalar uint32) *Vector2u {
-	return &Vector2u{
+func (v Vector3d) DivideScalar(scalar float64) Vector3d {
+	return Vector3d{
 		X: v.X / scalar,
 		Y: v.Y / scalar,
+		Z: v.Z / scalar,
 	}
 }
 
@@ -959,15 +2397,16 @@ func (v *Vector2u) DivideScalar(scalar uint32) *Vector2u {
 DivideScalars divides each component by its corresponding scalar.
 
 Params:
-  - x uint32, y uint32
+  - x float64, y float64, z float64
 
 Returns:
-  - A new Vector2u with each component divided.
+  - A new Vector3d with each component divided.
 */
-func (v *Vector2u) DivideScalars(x uint32, y uint32) *Vector2u {
-	return &Vector2u{
+func (v Vector3d) DivideScalars(x float64, y float64, z float64) Vector3d {
+	return Vector3d{
 		X: v.X / x,
 		Y: v.Y / y,
+		Z: v.Z / z,
 	}
 }
 
@@ -980,253 +2419,241 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *Vector2u) Equals(other *Vector2u) bool {
-	return v.X == other.X && v.Y == other.Y
+func (v Vector3d) Equals(other Vector3d) bool {
+	return v.X == other.X && v.Y == other.Y && v.Z == other.Z
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *Vector2u) String() string {
-	return fmt.Sprintf("Vector2u(X: %d, Y: %d)", v.X, v.Y)
+func (v Vector3d) String() string {
+	return fmt.Sprintf("Vector3d(X: %f, Y: %f, Z: %f)", v.X, v.Y, v.Z)
 }
 
-
-
-// ------------------- Vector3f Methods -------------------
-
 /*
-Add returns a new vector representing the component-wise sum
-of this vector and another.
-
-Params:
-  - other: the vector to add to this one.
+LengthSquared returns the squared magnitude of the vector.
 
 Returns:
-  - A new Vector3f representing the result of the addition.
+  - Sum of squares of components.
 */
-func (v *Vector3f) Add(other *Vector3f) *Vector3f {
-	return &Vector3f{
-		X: v.X + other.X,
-		Y: v.Y + other.Y,
-		Z: v.Z + other.Z,
-	}
+func (v Vector3d) LengthSquared() float64 {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
 }
 
 /*
-Subtract returns a new vector representing the component-wise difference
-between this vector and another.
+Dot returns the dot product with another vector.
 
 Params:
-  - other: the vector to subtract from this one.
+  - other: the vector to dot with.
 
 Returns:
-  - A new Vector3f representing the result of the subtraction.
+  - Dot product (scalar).
 */
-func (v *Vector3f) Subtract(other *Vector3f) *Vector3f {
-	return &Vector3f{
-		X: v.X - other.X,
-		Y: v.Y - other.Y,
-		Z: v.Z - other.Z,
-	}
+func (v Vector3d) Dot(other Vector3d) float64 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
 }
 
 /*
-Multiply returns a new vector with component-wise multiplication
-of this vector and another.
+DistanceSquared returns the squared distance between two vectors.
 
 Params:
-  - other: the vector to multiply with.
+  - other: the vector to measure distance to.
 
 Returns:
-  - A new Vector3f with each component multiplied.
+  - Squared distance (faster if exact distance isn't needed).
 */
-func (v *Vector3f) Multiply(other *Vector3f) *Vector3f {
-	return &Vector3f{
-		X: v.X * other.X,
-		Y: v.Y * other.Y,
-		Z: v.Z * other.Z,
-	}
+func (v Vector3d) DistanceSquared(other Vector3d) float64 {
+	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z)
 }
 
 /*
-MultiplyScalar multiplies each component of the vector by a scalar.
+Min returns the component-wise minimum of this vector and another.
 
 Params:
-  - scalar: the scalar value to multiply by.
+  - other: the vector to compare with.
 
 Returns:
-  - A new Vector3f with each component scaled.
+  - A new Vector3d with the smaller of each component.
 */
-func (v *Vector3f) MultiplyScalar(scalar float32) *Vector3f {
-	return &Vector3f{
-		X: v.X * scalar,
-		Y: v.Y * scalar,
-		Z: v.Z * scalar,
+func (v Vector3d) Min(other Vector3d) Vector3d {
+	return Vector3d{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+		Z: min(v.Z, other.Z),
 	}
 }
 
 /*
-MultiplyScalars performs component-wise multiplication with individual scalar values.
+Max returns the component-wise maximum of this vector and another.
 
 Params:
-  - x float32, y float32, z float32
+  - other: the vector to compare with.
 
 Returns:
-  - A new Vector3f with each component multiplied by its corresponding scalar.
+  - A new Vector3d with the larger of each component.
 */
-func (v *Vector3f) MultiplyScalars(x float32, y float32, z float32) *Vector3f {
-	return &Vector3f{
-		X: v.X * x,
-		Y: v.Y * y,
-		Z: v.Z * z,
+func (v Vector3d) Max(other Vector3d) Vector3d {
+	return Vector3d{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+		Z: max(v.Z, other.Z),
 	}
 }
 
 /*
-Divide performs component-wise division of this vector by another.
-
-Params:
-  - other: the vector to divide by.
+ToVector3f converts the vector to a Vector3f.
 
 Returns:
-  - A new Vector3f with each component divided.
+  - A new Vector3f.
 */
-func (v *Vector3f) Divide(other *Vector3f) *Vector3f {
-	return &Vector3f{
-		X: v.X / other.X,
-		Y: v.Y / other.Y,
-		Z: v.Z / other.Z,
+func (v Vector3d) ToVector3f() Vector3f {
+	return Vector3f{
+		X: float32(v.X),
+		Y: float32(v.Y),
+		Z: float32(v.Z),
 	}
 }
 
 /*
-DivideScalar divides each component by a scalar.
-
-Params:
-  - scalar: the scalar divisor.
+ToVector3i converts the vector to a Vector3i.
+Components are truncated toward zero; use Floor or Round first to round
+differently.
 
 Returns:
-  - A new Vector3f with each component divided by scalar.
+  - A new Vector3i.
 */
-func (v *Vector3f) DivideScalar(scalar float32) *Vector3f {
-	return &Vector3f{
-		X: v.X / scalar,
-		Y: v.Y / scalar,
-		Z: v.Z / scalar,
+func (v Vector3d) ToVector3i() Vector3i {
+	return Vector3i{
+		X: int32(v.X),
+		Y: int32(v.Y),
+		Z: int32(v.Z),
 	}
 }
 
 /*
-DivideScalars divides each component by its corresponding scalar.
-
-Params:
-  - x float32, y float32, z float32
+ToVector3u converts the vector to a Vector3u.
+Components are truncated toward zero; use Floor or Round first to round
+differently. Negative components become 0, as Go leaves converting
+them to an unsigned integer up to the platform.
 
 Returns:
-  - A new Vector3f with each component divided.
+  - A new Vector3u.
 */
-func (v *Vector3f) DivideScalars(x float32, y float32, z float32) *Vector3f {
-	return &Vector3f{
-		X: v.X / x,
-		Y: v.Y / y,
-		Z: v.Z / z,
+func (v Vector3d) ToVector3u() Vector3u {
+	return Vector3u{
+		X: uint32(max(0, v.X)),
+		Y: uint32(max(0, v.Y)),
+		Z: uint32(max(0, v.Z)),
 	}
 }
 
 /*
-Equals returns true if all components of both vectors are equal.
-
-Params:
-  - other: the vector to compare with.
+XY returns the X and Y components as a 2D vector.
+*/
+func (v Vector3d) XY() Vector2d {
+	return Vector2d{X: v.X, Y: v.Y}
+}
 
-Returns:
-  - Boolean indicating equality.
+/*
+XZ returns the X and Z components as a 2D vector.
 */
-func (v *Vector3f) Equals(other *Vector3f) bool {
-	return v.X == other.X && v.Y == other.Y && v.Z == other.Z
+func (v Vector3d) XZ() Vector2d {
+	return Vector2d{X: v.X, Y: v.Z}
 }
 
 /*
-String returns a formatted string representation of the vector.
+YZ returns the Y and Z components as a 2D vector.
 */
-func (v *Vector3f) String() string {
-	return fmt.Sprintf("Vector3f(X: %f, Y: %f, Z: %f)", v.X, v.Y, v.Z)
+func (v Vector3d) YZ() Vector2d {
+	return Vector2d{X: v.Y, Y: v.Z}
 }
 
 
-// --- Float-Specific Vector3f Methods ---
+// --- Signed Vector3d Methods ---
 
 /*
-LengthSquared returns the squared magnitude of the vector.
+Abs returns the vector with the absolute value of each component.
 
 Returns:
-  - Sum of squares of components.
+  - A new Vector3d with non-negative components.
 */
-func (v *Vector3f) LengthSquared() float32 {
-	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+func (v Vector3d) Abs() Vector3d {
+	return Vector3d{
+		X: float64(math.Abs(float64(v.X))),
+		Y: float64(math.Abs(float64(v.Y))),
+		Z: float64(math.Abs(float64(v.Z))),
+	}
 }
 
 /*
-Length returns the Euclidean length (magnitude) of the vector.
+Negate returns the vector pointing in the opposite direction.
 
 Returns:
-  - Square root of LengthSquared.
+  - A new Vector3d with each component negated.
 */
-func (v *Vector3f) Length() float32 {
-	return float32(math.Sqrt(float64(v.LengthSquared())))
+func (v Vector3d) Negate() Vector3d {
+	return Vector3d{
+		X: -v.X,
+		Y: -v.Y,
+		Z: -v.Z,
+	}
 }
 
 /*
-Normalize returns a unit vector pointing in the same direction.
+Cross returns the cross product of this vector and another.
+
+Params:
+  - other: the vector to cross with.
 
 Returns:
-  - A normalized vector, or zero vector if original length is 0.
+  - A new Vector3d perpendicular to both vectors.
 */
-func (v *Vector3f) Normalize() *Vector3f {
-	if l := v.Length(); l != 0 {
-		return v.DivideScalar(l)
+func (v Vector3d) Cross(other Vector3d) Vector3d {
+	return Vector3d{
+		X: v.Y*other.Z - v.Z*other.Y,
+		Y: v.Z*other.X - v.X*other.Z,
+		Z: v.X*other.Y - v.Y*other.X,
 	}
-	return &Vector3f{}
 }
 
-/*
-Dot returns the dot product with another vector.
 
-Params:
-  - other: the vector to dot with.
+
+// --- Float-Specific Vector3d Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector.
 
 Returns:
-  - Dot product (scalar).
+  - Square root of LengthSquared.
 */
-func (v *Vector3f) Dot(other *Vector3f) float32 {
-	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
+func (v Vector3d) Length() float64 {
+	return float64(math.Sqrt(float64(v.LengthSquared())))
 }
 
 /*
-Distance returns the Euclidean distance between two vectors.
-
-Params:
-  - other: the vector to measure distance to.
+Normalize returns a unit vector pointing in the same direction.
 
 Returns:
-  - Distance as a float.
+  - A normalized vector, or zero vector if original length is 0.
 */
-func (v *Vector3f) Distance(other *Vector3f) float32 {
-	return float32(math.Sqrt(float64(v.DistanceSquared(other))))
+func (v Vector3d) Normalize() Vector3d {
+	if l := v.Length(); l != 0 {
+		return v.DivideScalar(l)
+	}
+	return Vector3d{}
 }
 
 /*
-DistanceSquared returns the squared distance between two vectors.
+Distance returns the Euclidean distance between two vectors.
 
 Params:
   - other: the vector to measure distance to.
 
 Returns:
-  - Squared distance (faster if exact distance isn't needed).
+  - Distance as a float.
 */
-func (v *Vector3f) DistanceSquared(other *Vector3f) float32 {
-	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z)
+func (v Vector3d) Distance(other Vector3d) float64 {
+	return float64(math.Sqrt(float64(v.DistanceSquared(other))))
 }
 
 /*
@@ -1239,8 +2666,8 @@ Params:
 Returns:
   - Interpolated vector between this and other.
 */
-func (v *Vector3f) Lerp(other *Vector3f, t float32) *Vector3f {
-	return &Vector3f{
+func (v Vector3d) Lerp(other Vector3d, t float64) Vector3d {
+	return Vector3d{
 		X: v.X + (other.X - v.X)*t,
 		Y: v.Y + (other.Y - v.Y)*t,
 		Z: v.Z + (other.Z - v.Z)*t,
@@ -1257,11 +2684,11 @@ Params:
 Returns:
   - Clamped vector.
 */
-func (v *Vector3f) Clamp(min, max *Vector3f) *Vector3f {
-	return &Vector3f{
-		X: float32(math.Max(float64(min.X), math.Min(float64(max.X), float64(v.X)))),
-		Y: float32(math.Max(float64(min.Y), math.Min(float64(max.Y), float64(v.Y)))),
-		Z: float32(math.Max(float64(min.Z), math.Min(float64(max.Z), float64(v.Z)))),
+func (v Vector3d) Clamp(min, max Vector3d) Vector3d {
+	return Vector3d{
+		X: float64(math.Max(float64(min.X), math.Min(float64(max.X), float64(v.X)))),
+		Y: float64(math.Max(float64(min.Y), math.Min(float64(max.Y), float64(v.Y)))),
+		Z: float64(math.Max(float64(min.Z), math.Min(float64(max.Z), float64(v.Z)))),
 	}
 }
 
@@ -1274,9 +2701,9 @@ Params:
 Returns:
   - Reflected vector.
 */
-func (v *Vector3f) Reflect(normal *Vector3f) *Vector3f {
+func (v Vector3d) Reflect(normal Vector3d) Vector3d {
 	dot := v.Dot(normal)
-	return &Vector3f{
+	return Vector3d{
 		X: v.X - 2*dot*normal.X,
 		Y: v.Y - 2*dot*normal.Y,
 		Z: v.Z - 2*dot*normal.Z,
@@ -1292,14 +2719,14 @@ Params:
 Returns:
   - Projected vector.
 */
-func (v *Vector3f) Project(other *Vector3f) *Vector3f {
+func (v Vector3d) Project(other Vector3d) Vector3d {
 	dot := v.Dot(other)
 	lengthSquared := other.LengthSquared()
 	if lengthSquared == 0 {
-		return &Vector3f{}
+		return Vector3d{}
 	}
 	scalar := dot / lengthSquared
-	return &Vector3f{
+	return Vector3d{
 		X: other.X * scalar,
 		Y: other.Y * scalar,
 		Z: other.Z * scalar,
@@ -1307,24 +2734,85 @@ func (v *Vector3f) Project(other *Vector3f) *Vector3f {
 }
 
 /*
-SetLength returns a new vector in the same direction with a given length.
+SetLength returns a new vector in the same direction with a given length.
+
+Params:
+  - length: the desired length of the new vector.
+
+Returns:
+  - Rescaled vector, or zero vector if original length is zero.
+*/
+func (v Vector3d) SetLength(length float64) Vector3d {
+	if v.Length() == 0 {
+		return Vector3d{}
+	}
+	return v.Normalize().MultiplyScalar(length)
+}
+
+/*
+Floor rounds each component down.
+
+Returns:
+  - A new Vector3d with whole-number components.
+*/
+func (v Vector3d) Floor() Vector3d {
+	return Vector3d{
+		X: float64(math.Floor(float64(v.X))),
+		Y: float64(math.Floor(float64(v.Y))),
+		Z: float64(math.Floor(float64(v.Z))),
+	}
+}
+
+/*
+Ceil rounds each component up.
+
+Returns:
+  - A new Vector3d with whole-number components.
+*/
+func (v Vector3d) Ceil() Vector3d {
+	return Vector3d{
+		X: float64(math.Ceil(float64(v.X))),
+		Y: float64(math.Ceil(float64(v.Y))),
+		Z: float64(math.Ceil(float64(v.Z))),
+	}
+}
+
+/*
+Round rounds each component to the nearest whole number, halves away from zero.
+
+Returns:
+  - A new Vector3d with whole-number components.
+*/
+func (v Vector3d) Round() Vector3d {
+	return Vector3d{
+		X: float64(math.Round(float64(v.X))),
+		Y: float64(math.Round(float64(v.Y))),
+		Z: float64(math.Round(float64(v.Z))),
+	}
+}
+
+/*
+AngleTo returns the unsigned angle between this vector and another.
 
 Params:
-  - length: the desired length of the new vector.
+  - other: the vector to measure the angle to.
 
 Returns:
-  - Rescaled vector, or zero vector if original length is zero.
+  - Angle in degrees in [0, 180], or 0 if either vector has length 0.
 */
-func (v *Vector3f) SetLength(length float32) *Vector3f {
-	if v.Length() == 0 {
-		return &Vector3f{}
+func (v Vector3d) AngleTo(other Vector3d) float64 {
+	lengths := float64(v.Length()) * float64(other.Length())
+	if lengths == 0 {
+		return 0
 	}
-	return v.Normalize().MultiplyScalar(length)
+	cos := math.Max(-1, math.Min(1, float64(v.Dot(other))/lengths))
+	return float64(math.Acos(cos) * (180.0 / math.Pi))
 }
 
 
 
-// ------------------- Vector3d Methods -------------------
+
+// ------------------- Vector3i Methods -------------------
 
 /*
 Add returns a new vector representing the component-wise sum
@@ -1334,10 +2822,10 @@ Params:
   - other: the vector to add to this one.
 
 Returns:
-  - A new Vector3d representing the result of the addition.
+  - A new Vector3i representing the result of the addition.
 */
-func (v *Vector3d) Add(other *Vector3d) *Vector3d {
-	return &Vector3d{
+func (v Vector3i) Add(other Vector3i) Vector3i {
+	return Vector3i{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
 		Z: v.Z + other.Z,
@@ -1352,10 +2840,10 @@ Params:
   - other: the vector to subtract from this one.
 
 Returns:
-  - A new Vector3d representing the result of the subtraction.
+  - A new Vector3i representing the result of the subtraction.
 */
-func (v *Vector3d) Subtract(other *Vector3d) *Vector3d {
-	return &Vector3d{
+func (v Vector3i) Subtract(other Vector3i) Vector3i {
+	return Vector3i{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 		Z: v.Z - other.Z,
@@ -1370,10 +2858,10 @@ Params:
   - other: the vector to multiply with.
 
 Returns:
-  - A new Vector3d with each component multiplied.
+  - A new Vector3i with each component multiplied.
 */
-func (v *Vector3d) Multiply(other *Vector3d) *Vector3d {
-	return &Vector3d{
+func (v Vector3i) Multiply(other Vector3i) Vector3i {
+	return Vector3i{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
 		Z: v.Z * other.Z,
@@ -1387,10 +2875,10 @@ Params:
   - scalar: the scalar value to multiply by.
 
 Returns:
-  - A new Vector3d with each component scaled.
+  - A new Vector3i with each component scaled.
 */
-func (v *Vector3d) MultiplyScalar(scalar float64) *Vector3d {
-	return &Vector3d{
+func (v Vector3i) MultiplyScalar(scalar int32) Vector3i {
+	return Vector3i{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 		Z: v.Z * scalar,
@@ -1401,13 +2889,13 @@ func (v *Vector3d) MultiplyScalar(scalar float64) *Vector3d {
 MultiplyScalars performs component-wise multiplication with individual scalar values.
 
 Params:
-  - x float64, y float64, z float64
+  - x int32, y int32, z int32
 
 Returns:
-  - A new Vector3d with each component multiplied by its corresponding scalar.
+  - A new Vector3i with each component multiplied by its corresponding scalar.
 */
-func (v *Vector3d) MultiplyScalars(x float64, y float64, z float64) *Vector3d {
-	return &Vector3d{
+func (v Vector3i) MultiplyScalars(x int32, y int32, z int32) Vector3i {
+	return Vector3i{
 		X: v.X * x,
 		Y: v.Y * y,
 		Z: v.Z * z,
@@ -1421,10 +2909,10 @@ Params:
   - other: the vector to divide by.
 
 Returns:
-  - A new Vector3d with each component divided.
+  - A new Vector3i with each component divided.
 */
-func (v *Vector3d) Divide(other *Vector3d) *Vector3d {
-	return &Vector3d{
+func (v Vector3i) Divide(other Vector3i) Vector3i {
+	return Vector3i{
 		X: v.X / other.X,
 		Y: v.Y / other.Y,
 		Z: v.Z / other.Z,
@@ -1438,10 +2926,10 @@ Params:
   - scalar: the scalar divisor.
 
 Returns:
-  - A new Vector3d with each component divided by scalar.
+  - A new Vector3i with each component divided by scalar.
 */
-func (v *Vector3d) DivideScalar(scalar float64) *Vector3d {
-	return &Vector3d{
+func (v Vector3i) DivideScalar(scalar int32) Vector3i {
+	return Vector3i{
 		X: v.X / scalar,
 		Y: v.Y / scalar,
 		Z: v.Z / scalar,
@@ -1452,13 +2940,13 @@ func (v *Vector3d) DivideScalar(scalar float64) *Vector3d {
 DivideScalars divides each component by its corresponding scalar.
 
 Params:
-  - x float64, y float64, z float64
+  - x int32, y int32, z int32
 
 Returns:
-  - A new Vector3d with each component divided.
+  - A new Vector3i with each component divided.
 */
-func (v *Vector3d) DivideScalars(x float64, y float64, z float64) *Vector3d {
-	return &Vector3d{
+func (v Vector3i) DivideScalars(x int32, y int32, z int32) Vector3i {
+	return Vector3i{
 		X: v.X / x,
 		Y: v.Y / y,
 		Z: v.Z / z,
@@ -1474,188 +2962,231 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *Vector3d) Equals(other *Vector3d) bool {
+func (v Vector3i) Equals(other Vector3i) bool {
 	return v.X == other.X && v.Y == other.Y && v.Z == other.Z
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *Vector3d) String() string {
-	return fmt.Sprintf("Vector3d(X: %f, Y: %f, Z: %f)", v.X, v.Y, v.Z)
+func (v Vector3i) String() string {
+	return fmt.Sprintf("Vector3i(X: %d, Y: %d, Z: %d)", v.X, v.Y, v.Z)
 }
 
-
-// --- Float-Specific Vector3d Methods ---
-
 /*
 LengthSquared returns the squared magnitude of the vector.
 
 Returns:
   - Sum of squares of components.
 */
-func (v *Vector3d) LengthSquared() float64 {
+func (v Vector3i) LengthSquared() int32 {
 	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
 }
 
 /*
-Length returns the Euclidean length (magnitude) of the vector.
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
 
 Returns:
-  - Square root of LengthSquared.
+  - Dot product (scalar).
 */
-func (v *Vector3d) Length() float64 {
-	return float64(math.Sqrt(float64(v.LengthSquared())))
+func (v Vector3i) Dot(other Vector3i) int32 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
 }
 
 /*
-Normalize returns a unit vector pointing in the same direction.
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
 
 Returns:
-  - A normalized vector, or zero vector if original length is 0.
+  - Squared distance (faster if exact distance isn't needed).
 */
-func (v *Vector3d) Normalize() *Vector3d {
-	if l := v.Length(); l != 0 {
-		return v.DivideScalar(l)
-	}
-	return &Vector3d{}
+func (v Vector3i) DistanceSquared(other Vector3i) int32 {
+	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z)
 }
 
 /*
-Dot returns the dot product with another vector.
+Min returns the component-wise minimum of this vector and another.
 
 Params:
-  - other: the vector to dot with.
+  - other: the vector to compare with.
 
 Returns:
-  - Dot product (scalar).
+  - A new Vector3i with the smaller of each component.
 */
-func (v *Vector3d) Dot(other *Vector3d) float64 {
-	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
+func (v Vector3i) Min(other Vector3i) Vector3i {
+	return Vector3i{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+		Z: min(v.Z, other.Z),
+	}
 }
 
 /*
-Distance returns the Euclidean distance between two vectors.
+Max returns the component-wise maximum of this vector and another.
 
 Params:
-  - other: the vector to measure distance to.
+  - other: the vector to compare with.
 
 Returns:
-  - Distance as a float.
+  - A new Vector3i with the larger of each component.
 */
-func (v *Vector3d) Distance(other *Vector3d) float64 {
-	return float64(math.Sqrt(float64(v.DistanceSquared(other))))
+func (v Vector3i) Max(other Vector3i) Vector3i {
+	return Vector3i{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+		Z: max(v.Z, other.Z),
+	}
 }
 
 /*
-DistanceSquared returns the squared distance between two vectors.
-
-Params:
-  - other: the vector to measure distance to.
+ToVector3f converts the vector to a Vector3f.
 
 Returns:
-  - Squared distance (faster if exact distance isn't needed).
+  - A new Vector3f.
 */
-func (v *Vector3d) DistanceSquared(other *Vector3d) float64 {
-	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z)
+func (v Vector3i) ToVector3f() Vector3f {
+	return Vector3f{
+		X: float32(v.X),
+		Y: float32(v.Y),
+		Z: float32(v.Z),
+	}
 }
 
 /*
-Lerp performs linear interpolation toward another vector.
+ToVector3d converts the vector to a Vector3d.
 
-Params:
-  - other: target vector.
-  - t: interpolation factor in [0, 1].
+Returns:
+  - A new Vector3d.
+*/
+func (v Vector3i) ToVector3d() Vector3d {
+	return Vector3d{
+		X: float64(v.X),
+		Y: float64(v.Y),
+		Z: float64(v.Z),
+	}
+}
+
+/*
+ToVector3u converts the vector to a Vector3u.
+Components wrap around like Go integer conversions, so negative ones become
+large, e.g. -1 becomes 4294967295.
 
 Returns:
-  - Interpolated vector between this and other.
+  - A new Vector3u.
 */
-func (v *Vector3d) Lerp(other *Vector3d, t float64) *Vector3d {
-	return &Vector3d{
-		X: v.X + (other.X - v.X)*t,
-		Y: v.Y + (other.Y - v.Y)*t,
-		Z: v.Z + (other.Z - v.Z)*t,
+func (v Vector3i) ToVector3u() Vector3u {
+	return Vector3u{
+		X: uint32(v.X),
+		Y: uint32(v.Y),
+		Z: uint32(v.Z),
 	}
 }
 
 /*
-Clamp limits each component to the corresponding range.
+XY returns the X and Y components as a 2D vector.
+*/
+func (v Vector3i) XY() Vector2i {
+	return Vector2i{X: v.X, Y: v.Y}
+}
+
+/*
+XZ returns the X and Z components as a 2D vector.
+*/
+func (v Vector3i) XZ() Vector2i {
+	return Vector2i{X: v.X, Y: v.Z}
+}
+
+/*
+YZ returns the Y and Z components as a 2D vector.
+*/
+func (v Vector3i) YZ() Vector2i {
+	return Vector2i{X: v.Y, Y: v.Z}
+}
 
-Params:
-  - min: minimum vector values.
-  - max: maximum vector values.
+
+// --- Signed Vector3i Methods ---
+
+/*
+Abs returns the vector with the absolute value of each component.
 
 Returns:
-  - Clamped vector.
+  - A new Vector3i with non-negative components.
 */
-func (v *Vector3d) Clamp(min, max *Vector3d) *Vector3d {
-	return &Vector3d{
-		X: float64(math.Max(float64(min.X), math.Min(float64(max.X), float64(v.X)))),
-		Y: float64(math.Max(float64(min.Y), math.Min(float64(max.Y), float64(v.Y)))),
-		Z: float64(math.Max(float64(min.Z), math.Min(float64(max.Z), float64(v.Z)))),
+func (v Vector3i) Abs() Vector3i {
+	return Vector3i{
+		X: max(v.X, -v.X),
+		Y: max(v.Y, -v.Y),
+		Z: max(v.Z, -v.Z),
 	}
 }
 
 /*
-Reflect reflects this vector around a surface normal.
-
-Params:
-  - normal: surface normal vector.
+Negate returns the vector pointing in the opposite direction.
 
 Returns:
-  - Reflected vector.
+  - A new Vector3i with each component negated.
 */
-func (v *Vector3d) Reflect(normal *Vector3d) *Vector3d {
-	dot := v.Dot(normal)
-	return &Vector3d{
-		X: v.X - 2*dot*normal.X,
-		Y: v.Y - 2*dot*normal.Y,
-		Z: v.Z - 2*dot*normal.Z,
+func (v Vector3i) Negate() Vector3i {
+	return Vector3i{
+		X: -v.X,
+		Y: -v.Y,
+		Z: -v.Z,
 	}
 }
 
 /*
-Project projects this vector onto another.
+Cross returns the cross product of this vector and another.
 
 Params:
-  - other: vector to project onto.
+  - other: the vector to cross with.
 
 Returns:
-  - Projected vector.
+  - A new Vector3i perpendicular to both vectors.
 */
-func (v *Vector3d) Project(other *Vector3d) *Vector3d {
-	dot := v.Dot(other)
-	lengthSquared := other.LengthSquared()
-	if lengthSquared == 0 {
-		return &Vector3d{}
-	}
-	scalar := dot / lengthSquared
-	return &Vector3d{
-		X: other.X * scalar,
-		Y: other.Y * scalar,
-		Z: other.Z * scalar,
+func (v Vector3i) Cross(other Vector3i) Vector3i {
+	return Vector3i{
+		X: v.Y*other.Z - v.Z*other.Y,
+		Y: v.Z*other.X - v.X*other.Z,
+		Z: v.X*other.Y - v.Y*other.X,
 	}
 }
 
+
+// --- Integer Vector3i Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector, computed in
+float64 so it isn't truncated.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector3i) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
+}
+
 /*
-SetLength returns a new vector in the same direction with a given length.
+Distance returns the Euclidean distance between two vectors, computed in float64
+so it isn't truncated.
 
 Params:
-  - length: the desired length of the new vector.
+  - other: the vector to measure distance to.
 
 Returns:
-  - Rescaled vector, or zero vector if original length is zero.
+  - Distance as a float64.
 */
-func (v *Vector3d) SetLength(length float64) *Vector3d {
-	if v.Length() == 0 {
-		return &Vector3d{}
-	}
-	return v.Normalize().MultiplyScalar(length)
+func (v Vector3i) Distance(other Vector3i) float64 {
+	return math.Sqrt(float64(v.DistanceSquared(other)))
 }
 
 
 
-// ------------------- Vector3i Methods -------------------
+// ------------------- Vector3u Methods -------------------
 
 /*
 Add returns a new vector representing the component-wise sum
@@ -1665,10 +3196,10 @@ Params:
   - other: the vector to add to this one.
 
 Returns:
-  - A new Vector3i representing the result of the addition.
+  - A new Vector3u representing the result of the addition.
 */
-func (v *Vector3i) Add(other *Vector3i) *Vector3i {
-	return &Vector3i{
+func (v Vector3u) Add(other Vector3u) Vector3u {
+	return Vector3u{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
 		Z: v.Z + other.Z,
@@ -1683,10 +3214,10 @@ Params:
   - other: the vector to subtract from this one.
 
 Returns:
-  - A new Vector3i representing the result of the subtraction.
+  - A new Vector3u representing the result of the subtraction.
 */
-func (v *Vector3i) Subtract(other *Vector3i) *Vector3i {
-	return &Vector3i{
+func (v Vector3u) Subtract(other Vector3u) Vector3u {
+	return Vector3u{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 		Z: v.Z - other.Z,
@@ -1701,10 +3232,10 @@ Params:
   - other: the vector to multiply with.
 
 Returns:
-  - A new Vector3i with each component multiplied.
+  - A new Vector3u with each component multiplied.
 */
-func (v *Vector3i) Multiply(other *Vector3i) *Vector3i {
-	return &Vector3i{
+func (v Vector3u) Multiply(other Vector3u) Vector3u {
+	return Vector3u{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
 		Z: v.Z * other.Z,
@@ -1718,10 +3249,10 @@ Params:
   - scalar: the scalar value to multiply by.
 
 Returns:
-  - A new Vector3i with each component scaled.
+  - A new Vector3u with each component scaled.
 */
-func (v *Vector3i) MultiplyScalar(scalar int32) *Vector3i {
-	return &Vector3i{
+func (v Vector3u) MultiplyScalar(scalar uint32) Vector3u {
+	return Vector3u{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 		Z: v.Z * scalar,
@@ -1732,13 +3263,13 @@ func (v *Vector3i) MultiplyScalar(scalar int32) *Vector3i {
 MultiplyScalars performs component-wise multiplication with individual scalar values.
 
 Params:
-  - x int32, y int32, z int32
+  - x uint32, y uint32, z uint32
 
 Returns:
-  - A new Vector3i with each component multiplied by its corresponding scalar.
+  - A new Vector3u with each component multiplied by its corresponding scalar.
 */
-func (v *Vector3i) MultiplyScalars(x int32, y int32, z int32) *Vector3i {
-	return &Vector3i{
+func (v Vector3u) MultiplyScalars(x uint32, y uint32, z uint32) Vector3u {
+	return Vector3u{
 		X: v.X * x,
 		Y: v.Y * y,
 		Z: v.Z * z,
@@ -1752,10 +3283,10 @@ Params:
   - other: the vector to divide by.
 
 Returns:
-  - A new Vector3i with each component divided.
+  - A new Vector3u with each component divided.
 */
-func (v *Vector3i) Divide(other *Vector3i) *Vector3i {
-	return &Vector3i{
+func (v Vector3u) Divide(other Vector3u) Vector3u {
+	return Vector3u{
 		X: v.X / other.X,
 		Y: v.Y / other.Y,
 		Z: v.Z / other.Z,
@@ -1769,10 +3300,10 @@ Params:
   - scalar: the scalar divisor.
 
 Returns:
-  - A new Vector3i with each component divided by scalar.
+  - A new Vector3u with each component divided by scalar.
 */
-func (v *Vector3i) DivideScalar(scalar int32) *Vector3i {
-	return &Vector3i{
+func (v Vector3u) DivideScalar(scalar uint32) Vector3u {
+	return Vector3u{
 		X: v.X / scalar,
 		Y: v.Y / scalar,
 		Z: v.Z / scalar,
@@ -1783,13 +3314,13 @@ func (v *Vector3i) DivideScalar(scalar int32) *Vector3i {
 DivideScalars divides each component by its corresponding scalar.
 
 Params:
-  - x int32, y int32, z int32
+  - x uint32, y uint32, z uint32
 
 Returns:
-  - A new Vector3i with each component divided.
+  - A new Vector3u with each component divided.
 */
-func (v *Vector3i) DivideScalars(x int32, y int32, z int32) *Vector3i {
-	return &Vector3i{
+func (v Vector3u) DivideScalars(x uint32, y uint32, z uint32) Vector3u {
+	return Vector3u{
 		X: v.X / x,
 		Y: v.Y / y,
 		Z: v.Z / z,
@@ -1805,178 +3336,183 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *Vector3i) Equals(other *Vector3i) bool {
+func (v Vector3u) Equals(other Vector3u) bool {
 	return v.X == other.X && v.Y == other.Y && v.Z == other.Z
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *Vector3i) String() string {
-	return fmt.Sprintf("Vector3i(X: %d, Y: %d, Z: %d)", v.X, v.Y, v.Z)
+func (v Vector3u) String() string {
+	return fmt.Sprintf("Vector3u(X: %d, Y: %d, Z: %d)", v.X, v.Y, v.Z)
 }
 
+/*
+LengthSquared returns the squared magnitude of the vector.
 
-
-// ------------------- Vector3u Methods -------------------
+Returns:
+  - Sum of squares of components.
+*/
+func (v Vector3u) LengthSquared() uint32 {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+}
 
 /*
-Add returns a new vector representing the component-wise sum
-of this vector and another.
+Dot returns the dot product with another vector.
 
 Params:
-  - other: the vector to add to this one.
+  - other: the vector to dot with.
 
 Returns:
-  - A new Vector3u representing the result of the addition.
+  - Dot product (scalar).
 */
-func (v *Vector3u) Add(other *Vector3u) *Vector3u {
-	return &Vector3u{
-		X: v.X + other.X,
-		Y: v.Y + other.Y,
-		Z: v.Z + other.Z,
-	}
+func (v Vector3u) Dot(other Vector3u) uint32 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
 }
 
 /*
-Subtract returns a new vector representing the component-wise difference
-between this vector and another.
+DistanceSquared returns the squared distance between two vectors.
 
 Params:
-  - other: the vector to subtract from this one.
+  - other: the vector to measure distance to.
 
 Returns:
-  - A new Vector3u representing the result of the subtraction.
+  - Squared distance (faster if exact distance isn't needed).
 */
-func (v *Vector3u) Subtract(other *Vector3u) *Vector3u {
-	return &Vector3u{
-		X: v.X - other.X,
-		Y: v.Y - other.Y,
-		Z: v.Z - other.Z,
-	}
+func (v Vector3u) DistanceSquared(other Vector3u) uint32 {
+	// Subtract the smaller component, so the difference doesn't wrap around
+	x := max(v.X, other.X) - min(v.X, other.X)
+	y := max(v.Y, other.Y) - min(v.Y, other.Y)
+	z := max(v.Z, other.Z) - min(v.Z, other.Z)
+	return x*x + y*y + z*z
 }
 
 /*
-Multiply returns a new vector with component-wise multiplication
-of this vector and another.
+Min returns the component-wise minimum of this vector and another.
 
 Params:
-  - other: the vector to multiply with.
+  - other: the vector to compare with.
 
 Returns:
-  - A new Vector3u with each component multiplied.
+  - A new Vector3u with the smaller of each component.
 */
-func (v *Vector3u) Multiply(other *Vector3u) *Vector3u {
-	return &Vector3u{
-		X: v.X * other.X,
-		Y: v.Y * other.Y,
-		Z: v.Z * other.Z,
+func (v Vector3u) Min(other Vector3u) Vector3u {
+	return Vector3u{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+		Z: min(v.Z, other.Z),
 	}
 }
 
 /*
-MultiplyScalar multiplies each component of the vector by a scalar.
+Max returns the component-wise maximum of this vector and another.
 
 Params:
-  - scalar: the scalar value to multiply by.
+  - other: the vector to compare with.
 
 Returns:
-  - A new Vector3u with each component scaled.
+  - A new Vector3u with the larger of each component.
 */
-func (v *Vector3u) MultiplyScalar(scalar uint32) *Vector3u {
-	return &Vector3u{
-		X: v.X * scalar,
-		Y: v.Y * scalar,
-		Z: v.Z * scalar,
+func (v Vector3u) Max(other Vector3u) Vector3u {
+	return Vector3u{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+		Z: max(v.Z, other.Z),
 	}
 }
 
 /*
-MultiplyScalars performs component-wise multiplication with individual scalar values.
-
-Params:
-  - x uint32, y uint32, z uint32
+ToVector3f converts the vector to a Vector3f.
 
 Returns:
-  - A new Vector3u with each component multiplied by its corresponding scalar.
+  - A new Vector3f.
 */
-func (v *Vector3u) MultiplyScalars(x uint32, y uint32, z uint32) *Vector3u {
-	return &Vector3u{
-		X: v.X * x,
-		Y: v.Y * y,
-		Z: v.Z * z,
+func (v Vector3u) ToVector3f() Vector3f {
+	return Vector3f{
+		X: float32(v.X),
+		Y: float32(v.Y),
+		Z: float32(v.Z),
 	}
 }
 
 /*
-Divide performs component-wise division of this vector by another.
-
-Params:
-  - other: the vector to divide by.
+ToVector3d converts the vector to a Vector3d.
 
 Returns:
-  - A new Vector3u with each component divided.
+  - A new Vector3d.
 */
-func (v *Vector3u) Divide(other *Vector3u) *Vector3u {
-	return &Vector3u{
-		X: v.X / other.X,
-		Y: v.Y / other.Y,
-		Z: v.Z / other.Z,
+func (v Vector3u) ToVector3d() Vector3d {
+	return Vector3d{
+		X: float64(v.X),
+		Y: float64(v.Y),
+		Z: float64(v.Z),
 	}
 }
 
 /*
-DivideScalar divides each component by a scalar.
-
-Params:
-  - scalar: the scalar divisor.
+ToVector3i converts the vector to a Vector3i.
+Components wrap around like Go integer conversions, so ones above
+2147483647 become negative.
 
 Returns:
-  - A new Vector3u with each component divided by scalar.
+  - A new Vector3i.
 */
-func (v *Vector3u) DivideScalar(scalar uint32) *Vector3u {
-	return &Vector3u{
-		X: v.X / scalar,
-		Y: v.Y / scalar,
-		Z: v.Z / scalar,
+func (v Vector3u) ToVector3i() Vector3i {
+	return Vector3i{
+		X: int32(v.X),
+		Y: int32(v.Y),
+		Z: int32(v.Z),
 	}
 }
 
 /*
-DivideScalars divides each component by its corresponding scalar.
+XY returns the X and Y components as a 2D vector.
+*/
+func (v Vector3u) XY() Vector2u {
+	return Vector2u{X: v.X, Y: v.Y}
+}
 
-Params:
-  - x uint32, y uint32, z uint32
+/*
+XZ returns the X and Z components as a 2D vector.
+*/
+func (v Vector3u) XZ() Vector2u {
+	return Vector2u{X: v.X, Y: v.Z}
+}
 
-Returns:
-  - A new Vector3u with each component divided.
+/*
+YZ returns the Y and Z components as a 2D vector.
 */
-func (v *Vector3u) DivideScalars(x uint32, y uint32, z uint32) *Vector3u {
-	return &Vector3u{
-		X: v.X / x,
-		Y: v.Y / y,
-		Z: v.Z / z,
-	}
+func (v Vector3u) YZ() Vector2u {
+	return Vector2u{X: v.Y, Y: v.Z}
 }
 
+
+
+// --- Integer Vector3u Methods ---
+
 /*
-Equals returns true if all components of both vectors are equal.
-
-Params:
-  - other: the vector to compare with.
+Length returns the Euclidean length (magnitude) of the vector, computed in
+float64 so it isn't truncated.
 
 Returns:
-  - Boolean indicating equality.
+  - Square root of LengthSquared.
 */
-func (v *Vector3u) Equals(other *Vector3u) bool {
-	return v.X == other.X && v.Y == other.Y && v.Z == other.Z
+func (v Vector3u) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
 }
 
 /*
-String returns a formatted string representation of the vector.
+Distance returns the Euclidean distance between two vectors, computed in float64
+so it isn't truncated.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Distance as a float64.
 */
-func (v *Vector3u) String() string {
-	return fmt.Sprintf("Vector3u(X: %d, Y: %d, Z: %d)", v.X, v.Y, v.Z)
+func (v Vector3u) Distance(other Vector3u) float64 {
+	return math.Sqrt(float64(v.DistanceSquared(other)))
 }
 
 
@@ -1993,8 +3529,8 @@ Params:
 Returns:
   - A new Vector4f representing the result of the addition.
 */
-func (v *Vector4f) Add(other *Vector4f) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) Add(other Vector4f) Vector4f {
+	return Vector4f{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
 		Z: v.Z + other.Z,
@@ -2012,8 +3548,8 @@ Params:
 Returns:
   - A new Vector4f representing the result of the subtraction.
 */
-func (v *Vector4f) Subtract(other *Vector4f) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) Subtract(other Vector4f) Vector4f {
+	return Vector4f{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 		Z: v.Z - other.Z,
@@ -2031,8 +3567,8 @@ Params:
 Returns:
   - A new Vector4f with each component multiplied.
 */
-func (v *Vector4f) Multiply(other *Vector4f) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) Multiply(other Vector4f) Vector4f {
+	return Vector4f{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
 		Z: v.Z * other.Z,
@@ -2049,8 +3585,8 @@ Params:
 Returns:
   - A new Vector4f with each component scaled.
 */
-func (v *Vector4f) MultiplyScalar(scalar float32) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) MultiplyScalar(scalar float32) Vector4f {
+	return Vector4f{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 		Z: v.Z * scalar,
@@ -2067,8 +3603,8 @@ Params:
 Returns:
   - A new Vector4f with each component multiplied by its corresponding scalar.
 */
-func (v *Vector4f) MultiplyScalars(x float32, y float32, z float32, w float32) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) MultiplyScalars(x float32, y float32, z float32, w float32) Vector4f {
+	return Vector4f{
 		X: v.X * x,
 		Y: v.Y * y,
 		Z: v.Z * z,
@@ -2085,8 +3621,8 @@ Params:
 Returns:
   - A new Vector4f with each component divided.
 */
-func (v *Vector4f) Divide(other *Vector4f) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) Divide(other Vector4f) Vector4f {
+	return Vector4f{
 		X: v.X / other.X,
 		Y: v.Y / other.Y,
 		Z: v.Z / other.Z,
@@ -2103,8 +3639,8 @@ Params:
 Returns:
   - A new Vector4f with each component divided by scalar.
 */
-func (v *Vector4f) DivideScalar(scalar float32) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) DivideScalar(scalar float32) Vector4f {
+	return Vector4f{
 		X: v.X / scalar,
 		Y: v.Y / scalar,
 		Z: v.Z / scalar,
@@ -2121,8 +3657,8 @@ Params:
 Returns:
   - A new Vector4f with each component divided.
 */
-func (v *Vector4f) DivideScalars(x float32, y float32, z float32, w float32) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) DivideScalars(x float32, y float32, z float32, w float32) Vector4f {
+	return Vector4f{
 		X: v.X / x,
 		Y: v.Y / y,
 		Z: v.Z / z,
@@ -2139,90 +3675,224 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *Vector4f) Equals(other *Vector4f) bool {
+func (v Vector4f) Equals(other Vector4f) bool {
 	return v.X == other.X && v.Y == other.Y && v.Z == other.Z && v.W == other.W
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *Vector4f) String() string {
+func (v Vector4f) String() string {
 	return fmt.Sprintf("Vector4f(X: %f, Y: %f, Z: %f, W: %f)", v.X, v.Y, v.Z, v.W)
 }
 
-
-// --- Float-Specific Vector4f Methods ---
-
 /*
 LengthSquared returns the squared magnitude of the vector.
 
 Returns:
   - Sum of squares of components.
 */
-func (v *Vector4f) LengthSquared() float32 {
+func (v Vector4f) LengthSquared() float32 {
 	return v.X*v.X + v.Y*v.Y + v.Z*v.Z + v.W*v.W
 }
 
 /*
-Length returns the Euclidean length (magnitude) of the vector.
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
 
 Returns:
-  - Square root of LengthSquared.
+  - Dot product (scalar).
 */
-func (v *Vector4f) Length() float32 {
-	return float32(math.Sqrt(float64(v.LengthSquared())))
+func (v Vector4f) Dot(other Vector4f) float32 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z + v.W*other.W
 }
 
 /*
-Normalize returns a unit vector pointing in the same direction.
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
 
 Returns:
-  - A normalized vector, or zero vector if original length is 0.
+  - Squared distance (faster if exact distance isn't needed).
 */
-func (v *Vector4f) Normalize() *Vector4f {
-	if l := v.Length(); l != 0 {
-		return v.DivideScalar(l)
-	}
-	return &Vector4f{}
+func (v Vector4f) DistanceSquared(other Vector4f) float32 {
+	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z) + (v.W - other.W)*(v.W - other.W)
 }
 
 /*
-Dot returns the dot product with another vector.
+Min returns the component-wise minimum of this vector and another.
 
 Params:
-  - other: the vector to dot with.
+  - other: the vector to compare with.
 
 Returns:
-  - Dot product (scalar).
+  - A new Vector4f with the smaller of each component.
 */
-func (v *Vector4f) Dot(other *Vector4f) float32 {
-	return v.X*other.X + v.Y*other.Y + v.Z*other.Z + v.W*other.W
+func (v Vector4f) Min(other Vector4f) Vector4f {
+	return Vector4f{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+		Z: min(v.Z, other.Z),
+		W: min(v.W, other.W),
+	}
 }
 
 /*
-Distance returns the Euclidean distance between two vectors.
+Max returns the component-wise maximum of this vector and another.
 
 Params:
-  - other: the vector to measure distance to.
+  - other: the vector to compare with.
 
 Returns:
-  - Distance as a float.
+  - A new Vector4f with the larger of each component.
 */
-func (v *Vector4f) Distance(other *Vector4f) float32 {
-	return float32(math.Sqrt(float64(v.DistanceSquared(other))))
+func (v Vector4f) Max(other Vector4f) Vector4f {
+	return Vector4f{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+		Z: max(v.Z, other.Z),
+		W: max(v.W, other.W),
+	}
 }
 
 /*
-DistanceSquared returns the squared distance between two vectors.
+ToVector4d converts the vector to a Vector4d.
+
+Returns:
+  - A new Vector4d.
+*/
+func (v Vector4f) ToVector4d() Vector4d {
+	return Vector4d{
+		X: float64(v.X),
+		Y: float64(v.Y),
+		Z: float64(v.Z),
+		W: float64(v.W),
+	}
+}
+
+/*
+ToVector4i converts the vector to a Vector4i.
+Components are truncated toward zero; use Floor or Round first to round
+differently.
+
+Returns:
+  - A new Vector4i.
+*/
+func (v Vector4f) ToVector4i() Vector4i {
+	return Vector4i{
+		X: int32(v.X),
+		Y: int32(v.Y),
+		Z: int32(v.Z),
+		W: int32(v.W),
+	}
+}
+
+/*
+ToVector4u converts the vector to a Vector4u.
+Components are truncated toward zero; use Floor or Round first to round
+differently. Negative components become 0, as Go leaves converting
+them to an unsigned integer up to the platform.
+
+Returns:
+  - A new Vector4u.
+*/
+func (v Vector4f) ToVector4u() Vector4u {
+	return Vector4u{
+		X: uint32(max(0, v.X)),
+		Y: uint32(max(0, v.Y)),
+		Z: uint32(max(0, v.Z)),
+		W: uint32(max(0, v.W)),
+	}
+}
+
+/*
+XY returns the X and Y components as a 2D vector.
+*/
+func (v Vector4f) XY() Vector2f {
+	return Vector2f{X: v.X, Y: v.Y}
+}
+
+/*
+XYZ returns the X, Y and Z components as a 3D vector.
+*/
+func (v Vector4f) XYZ() Vector3f {
+	return Vector3f{X: v.X, Y: v.Y, Z: v.Z}
+}
+
+
+// --- Signed Vector4f Methods ---
+
+/*
+Abs returns the vector with the absolute value of each component.
+
+Returns:
+  - A new Vector4f with non-negative components.
+*/
+func (v Vector4f) Abs() Vector4f {
+	return Vector4f{
+		X: float32(math.Abs(float64(v.X))),
+		Y: float32(math.Abs(float64(v.Y))),
+		Z: float32(math.Abs(float64(v.Z))),
+		W: float32(math.Abs(float64(v.W))),
+	}
+}
+
+/*
+Negate returns the vector pointing in the opposite direction.
+
+Returns:
+  - A new Vector4f with each component negated.
+*/
+func (v Vector4f) Negate() Vector4f {
+	return Vector4f{
+		X: -v.X,
+		Y: -v.Y,
+		Z: -v.Z,
+		W: -v.W,
+	}
+}
+
+
+
+// --- Float-Specific Vector4f Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector4f) Length() float32 {
+	return float32(math.Sqrt(float64(v.LengthSquared())))
+}
+
+/*
+Normalize returns a unit vector pointing in the same direction.
+
+Returns:
+  - A normalized vector, or zero vector if original length is 0.
+*/
+func (v Vector4f) Normalize() Vector4f {
+	if l := v.Length(); l != 0 {
+		return v.DivideScalar(l)
+	}
+	return Vector4f{}
+}
+
+/*
+Distance returns the Euclidean distance between two vectors.
 
 Params:
   - other: the vector to measure distance to.
 
 Returns:
-  - Squared distance (faster if exact distance isn't needed).
+  - Distance as a float.
 */
-func (v *Vector4f) DistanceSquared(other *Vector4f) float32 {
-	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z) + (v.W - other.W)*(v.W - other.W)
+func (v Vector4f) Distance(other Vector4f) float32 {
+	return float32(math.Sqrt(float64(v.DistanceSquared(other))))
 }
 
 /*
@@ -2235,8 +3905,8 @@ Params:
 Returns:
   - Interpolated vector between this and other.
 */
-func (v *Vector4f) Lerp(other *Vector4f, t float32) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) Lerp(other Vector4f, t float32) Vector4f {
+	return Vector4f{
 		X: v.X + (other.X - v.X)*t,
 		Y: v.Y + (other.Y - v.Y)*t,
 		Z: v.Z + (other.Z - v.Z)*t,
@@ -2254,8 +3924,8 @@ Params:
 Returns:
   - Clamped vector.
 */
-func (v *Vector4f) Clamp(min, max *Vector4f) *Vector4f {
-	return &Vector4f{
+func (v Vector4f) Clamp(min, max Vector4f) Vector4f {
+	return Vector4f{
 		X: float32(math.Max(float64(min.X), math.Min(float64(max.X), float64(v.X)))),
 		Y: float32(math.Max(float64(min.Y), math.Min(float64(max.Y), float64(v.Y)))),
 		Z: float32(math.Max(float64(min.Z), math.Min(float64(max.Z), float64(v.Z)))),
@@ -2272,9 +3942,9 @@ Params:
 Returns:
   - Reflected vector.
 */
-func (v *Vector4f) Reflect(normal *Vector4f) *Vector4f {
+func (v Vector4f) Reflect(normal Vector4f) Vector4f {
 	dot := v.Dot(normal)
-	return &Vector4f{
+	return Vector4f{
 		X: v.X - 2*dot*normal.X,
 		Y: v.Y - 2*dot*normal.Y,
 		Z: v.Z - 2*dot*normal.Z,
@@ -2291,14 +3961,14 @@ Params:
 Returns:
   - Projected vector.
 */
-func (v *Vector4f) Project(other *Vector4f) *Vector4f {
+func (v Vector4f) Project(other Vector4f) Vector4f {
 	dot := v.Dot(other)
 	lengthSquared := other.LengthSquared()
 	if lengthSquared == 0 {
-		return &Vector4f{}
+		return Vector4f{}
 	}
 	scalar := dot / lengthSquared
-	return &Vector4f{
+	return Vector4f{
 		X: other.X * scalar,
 		Y: other.Y * scalar,
 		Z: other.Z * scalar,
@@ -2315,13 +3985,77 @@ Params:
 Returns:
   - Rescaled vector, or zero vector if original length is zero.
 */
-func (v *Vector4f) SetLength(length float32) *Vector4f {
+func (v Vector4f) SetLength(length float32) Vector4f {
 	if v.Length() == 0 {
-		return &Vector4f{}
+		return Vector4f{}
 	}
 	return v.Normalize().MultiplyScalar(length)
 }
 
+/*
+Floor rounds each component down.
+
+Returns:
+  - A new Vector4f with whole-number components.
+*/
+func (v Vector4f) Floor() Vector4f {
+	return Vector4f{
+		X: float32(math.Floor(float64(v.X))),
+		Y: float32(math.Floor(float64(v.Y))),
+		Z: float32(math.Floor(float64(v.Z))),
+		W: float32(math.Floor(float64(v.W))),
+	}
+}
+
+/*
+Ceil rounds each component up.
+
+Returns:
+  - A new Vector4f with whole-number components.
+*/
+func (v Vector4f) Ceil() Vector4f {
+	return Vector4f{
+		X: float32(math.Ceil(float64(v.X))),
+		Y: float32(math.Ceil(float64(v.Y))),
+		Z: float32(math.Ceil(float64(v.Z))),
+		W: float32(math.Ceil(float64(v.W))),
+	}
+}
+
+/*
+Round rounds each component to the nearest whole number, halves away from zero.
+
+Returns:
+  - A new Vector4f with whole-number components.
+*/
+func (v Vector4f) Round() Vector4f {
+	return Vector4f{
+		X: float32(math.Round(float64(v.X))),
+		Y: float32(math.Round(float64(v.Y))),
+		Z: float32(math.Round(float64(v.Z))),
+		W: float32(math.Round(float64(v.W))),
+	}
+}
+
+/*
+AngleTo returns the unsigned angle between this vector and another.
+
+Params:
+  - other: the vector to measure the angle to.
+
+Returns:
+  - Angle in degrees in [0, 180], or 0 if either vector has length 0.
+*/
+func (v Vector4f) AngleTo(other Vector4f) float32 {
+	lengths := float64(v.Length()) * float64(other.Length())
+	if lengths == 0 {
+		return 0
+	}
+	cos := math.Max(-1, math.Min(1, float64(v.Dot(other))/lengths))
+	return float32(math.Acos(cos) * (180.0 / math.Pi))
+}
+
+
 
 
 // ------------------- Vector4d Methods -------------------
@@ -2336,8 +4070,8 @@ Params:
 Returns:
   - A new Vector4d representing the result of the addition.
 */
-func (v *Vector4d) Add(other *Vector4d) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) Add(other Vector4d) Vector4d {
+	return Vector4d{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
 		Z: v.Z + other.Z,
@@ -2355,8 +4089,8 @@ Params:
 Returns:
   - A new Vector4d representing the result of the subtraction.
 */
-func (v *Vector4d) Subtract(other *Vector4d) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) Subtract(other Vector4d) Vector4d {
+	return Vector4d{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 		Z: v.Z - other.Z,
@@ -2374,8 +4108,8 @@ Params:
 Returns:
   - A new Vector4d with each component multiplied.
 */
-func (v *Vector4d) Multiply(other *Vector4d) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) Multiply(other Vector4d) Vector4d {
+	return Vector4d{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
 		Z: v.Z * other.Z,
@@ -2392,8 +4126,8 @@ Params:
 Returns:
   - A new Vector4d with each component scaled.
 */
-func (v *Vector4d) MultiplyScalar(scalar float64) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) MultiplyScalar(scalar float64) Vector4d {
+	return Vector4d{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 		Z: v.Z * scalar,
@@ -2410,8 +4144,8 @@ Params:
 Returns:
   - A new Vector4d with each component multiplied by its corresponding scalar.
 */
-func (v *Vector4d) MultiplyScalars(x float64, y float64, z float64, w float64) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) MultiplyScalars(x float64, y float64, z float64, w float64) Vector4d {
+	return Vector4d{
 		X: v.X * x,
 		Y: v.Y * y,
 		Z: v.Z * z,
@@ -2428,8 +4162,8 @@ Params:
 Returns:
   - A new Vector4d with each component divided.
 */
-func (v *Vector4d) Divide(other *Vector4d) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) Divide(other Vector4d) Vector4d {
+	return Vector4d{
 		X: v.X / other.X,
 		Y: v.Y / other.Y,
 		Z: v.Z / other.Z,
@@ -2446,8 +4180,8 @@ Params:
 Returns:
   - A new Vector4d with each component divided by scalar.
 */
-func (v *Vector4d) DivideScalar(scalar float64) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) DivideScalar(scalar float64) Vector4d {
+	return Vector4d{
 		X: v.X / scalar,
 		Y: v.Y / scalar,
 		Z: v.Z / scalar,
@@ -2464,8 +4198,8 @@ Params:
 Returns:
   - A new Vector4d with each component divided.
 */
-func (v *Vector4d) DivideScalars(x float64, y float64, z float64, w float64) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) DivideScalars(x float64, y float64, z float64, w float64) Vector4d {
+	return Vector4d{
 		X: v.X / x,
 		Y: v.Y / y,
 		Z: v.Z / z,
@@ -2482,90 +4216,224 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *Vector4d) Equals(other *Vector4d) bool {
+func (v Vector4d) Equals(other Vector4d) bool {
 	return v.X == other.X && v.Y == other.Y && v.Z == other.Z && v.W == other.W
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *Vector4d) String() string {
+func (v Vector4d) String() string {
 	return fmt.Sprintf("Vector4d(X: %f, Y: %f, Z: %f, W: %f)", v.X, v.Y, v.Z, v.W)
 }
 
-
-// --- Float-Specific Vector4d Methods ---
-
 /*
 LengthSquared returns the squared magnitude of the vector.
 
 Returns:
   - Sum of squares of components.
 */
-func (v *Vector4d) LengthSquared() float64 {
+func (v Vector4d) LengthSquared() float64 {
 	return v.X*v.X + v.Y*v.Y + v.Z*v.Z + v.W*v.W
 }
 
 /*
-Length returns the Euclidean length (magnitude) of the vector.
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
 
 Returns:
-  - Square root of LengthSquared.
+  - Dot product (scalar).
 */
-func (v *Vector4d) Length() float64 {
-	return float64(math.Sqrt(float64(v.LengthSquared())))
+func (v Vector4d) Dot(other Vector4d) float64 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z + v.W*other.W
 }
 
 /*
-Normalize returns a unit vector pointing in the same direction.
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
 
 Returns:
-  - A normalized vector, or zero vector if original length is 0.
+  - Squared distance (faster if exact distance isn't needed).
 */
-func (v *Vector4d) Normalize() *Vector4d {
-	if l := v.Length(); l != 0 {
-		return v.DivideScalar(l)
-	}
-	return &Vector4d{}
+func (v Vector4d) DistanceSquared(other Vector4d) float64 {
+	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z) + (v.W - other.W)*(v.W - other.W)
 }
 
 /*
-Dot returns the dot product with another vector.
+Min returns the component-wise minimum of this vector and another.
 
 Params:
-  - other: the vector to dot with.
+  - other: the vector to compare with.
 
 Returns:
-  - Dot product (scalar).
+  - A new Vector4d with the smaller of each component.
 */
-func (v *Vector4d) Dot(other *Vector4d) float64 {
-	return v.X*other.X + v.Y*other.Y + v.Z*other.Z + v.W*other.W
+func (v Vector4d) Min(other Vector4d) Vector4d {
+	return Vector4d{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+		Z: min(v.Z, other.Z),
+		W: min(v.W, other.W),
+	}
 }
 
 /*
-Distance returns the Euclidean distance between two vectors.
+Max returns the component-wise maximum of this vector and another.
 
 Params:
-  - other: the vector to measure distance to.
+  - other: the vector to compare with.
 
 Returns:
-  - Distance as a float.
+  - A new Vector4d with the larger of each component.
 */
-func (v *Vector4d) Distance(other *Vector4d) float64 {
-	return float64(math.Sqrt(float64(v.DistanceSquared(other))))
+func (v Vector4d) Max(other Vector4d) Vector4d {
+	return Vector4d{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+		Z: max(v.Z, other.Z),
+		W: max(v.W, other.W),
+	}
+}
+
+/*
+ToVector4f converts the vector to a Vector4f.
+
+Returns:
+  - A new Vector4f.
+*/
+func (v Vector4d) ToVector4f() Vector4f {
+	return Vector4f{
+		X: float32(v.X),
+		Y: float32(v.Y),
+		Z: float32(v.Z),
+		W: float32(v.W),
+	}
+}
+
+/*
+ToVector4i converts the vector to a Vector4i.
+Components are truncated toward zero; use Floor or Round first to round
+differently.
+
+Returns:
+  - A new Vector4i.
+*/
+func (v Vector4d) ToVector4i() Vector4i {
+	return Vector4i{
+		X: int32(v.X),
+		Y: int32(v.Y),
+		Z: int32(v.Z),
+		W: int32(v.W),
+	}
+}
+
+/*
+ToVector4u converts the vector to a Vector4u.
+Components are truncated toward zero; use Floor or Round first to round
+differently. Negative components become 0, as Go leaves converting
+them to an unsigned integer up to the platform.
+
+Returns:
+  - A new Vector4u.
+*/
+func (v Vector4d) ToVector4u() Vector4u {
+	return Vector4u{
+		X: uint32(max(0, v.X)),
+		Y: uint32(max(0, v.Y)),
+		Z: uint32(max(0, v.Z)),
+		W: uint32(max(0, v.W)),
+	}
+}
+
+/*
+XY returns the X and Y components as a 2D vector.
+*/
+func (v Vector4d) XY() Vector2d {
+	return Vector2d{X: v.X, Y: v.Y}
+}
+
+/*
+XYZ returns the X, Y and Z components as a 3D vector.
+*/
+func (v Vector4d) XYZ() Vector3d {
+	return Vector3d{X: v.X, Y: v.Y, Z: v.Z}
+}
+
+
+// --- Signed Vector4d Methods ---
+
+/*
+Abs returns the vector with the absolute value of each component.
+
+Returns:
+  - A new Vector4d with non-negative components.
+*/
+func (v Vector4d) Abs() Vector4d {
+	return Vector4d{
+		X: float64(math.Abs(float64(v.X))),
+		Y: float64(math.Abs(float64(v.Y))),
+		Z: float64(math.Abs(float64(v.Z))),
+		W: float64(math.Abs(float64(v.W))),
+	}
+}
+
+/*
+Negate returns the vector pointing in the opposite direction.
+
+Returns:
+  - A new Vector4d with each component negated.
+*/
+func (v Vector4d) Negate() Vector4d {
+	return Vector4d{
+		X: -v.X,
+		Y: -v.Y,
+		Z: -v.Z,
+		W: -v.W,
+	}
+}
+
+
+
+// --- Float-Specific Vector4d Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector4d) Length() float64 {
+	return float64(math.Sqrt(float64(v.LengthSquared())))
+}
+
+/*
+Normalize returns a unit vector pointing in the same direction.
+
+Returns:
+  - A normalized vector, or zero vector if original length is 0.
+*/
+func (v Vector4d) Normalize() Vector4d {
+	if l := v.Length(); l != 0 {
+		return v.DivideScalar(l)
+	}
+	return Vector4d{}
 }
 
 /*
-DistanceSquared returns the squared distance between two vectors.
+Distance returns the Euclidean distance between two vectors.
 
 Params:
   - other: the vector to measure distance to.
 
 Returns:
-  - Squared distance (faster if exact distance isn't needed).
+  - Distance as a float.
 */
-func (v *Vector4d) DistanceSquared(other *Vector4d) float64 {
-	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z) + (v.W - other.W)*(v.W - other.W)
+func (v Vector4d) Distance(other Vector4d) float64 {
+	return float64(math.Sqrt(float64(v.DistanceSquared(other))))
 }
 
 /*
@@ -2578,8 +4446,8 @@ Params:
 Returns:
   - Interpolated vector between this and other.
 */
-func (v *Vector4d) Lerp(other *Vector4d, t float64) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) Lerp(other Vector4d, t float64) Vector4d {
+	return Vector4d{
 		X: v.X + (other.X - v.X)*t,
 		Y: v.Y + (other.Y - v.Y)*t,
 		Z: v.Z + (other.Z - v.Z)*t,
@@ -2597,8 +4465,8 @@ Params:
 Returns:
   - Clamped vector.
 */
-func (v *Vector4d) Clamp(min, max *Vector4d) *Vector4d {
-	return &Vector4d{
+func (v Vector4d) Clamp(min, max Vector4d) Vector4d {
+	return Vector4d{
 		X: float64(math.Max(float64(min.X), math.Min(float64(max.X), float64(v.X)))),
 		Y: float64(math.Max(float64(min.Y), math.Min(float64(max.Y), float64(v.Y)))),
 		Z: float64(math.Max(float64(min.Z), math.Min(float64(max.Z), float64(v.Z)))),
@@ -2615,9 +4483,9 @@ Params:
 Returns:
   - Reflected vector.
 */
-func (v *Vector4d) Reflect(normal *Vector4d) *Vector4d {
+func (v Vector4d) Reflect(normal Vector4d) Vector4d {
 	dot := v.Dot(normal)
-	return &Vector4d{
+	return Vector4d{
 		X: v.X - 2*dot*normal.X,
 		Y: v.Y - 2*dot*normal.Y,
 		Z: v.Z - 2*dot*normal.Z,
@@ -2634,14 +4502,14 @@ Params:
 Returns:
   - Projected vector.
 */
-func (v *Vector4d) Project(other *Vector4d) *Vector4d {
+func (v Vector4d) Project(other Vector4d) Vector4d {
 	dot := v.Dot(other)
 	lengthSquared := other.LengthSquared()
 	if lengthSquared == 0 {
-		return &Vector4d{}
+		return Vector4d{}
 	}
 	scalar := dot / lengthSquared
-	return &Vector4d{
+	return Vector4d{
 		X: other.X * scalar,
 		Y: other.Y * scalar,
 		Z: other.Z * scalar,
@@ -2658,13 +4526,77 @@ Params:
 Returns:
   - Rescaled vector, or zero vector if original length is zero.
 */
-func (v *Vector4d) SetLength(length float64) *Vector4d {
+func (v Vector4d) SetLength(length float64) Vector4d {
 	if v.Length() == 0 {
-		return &Vector4d{}
+		return Vector4d{}
 	}
 	return v.Normalize().MultiplyScalar(length)
 }
 
+/*
+Floor rounds each component down.
+
+Returns:
+  - A new Vector4d with whole-number components.
+*/
+func (v Vector4d) Floor() Vector4d {
+	return Vector4d{
+		X: float64(math.Floor(float64(v.X))),
+		Y: float64(math.Floor(float64(v.Y))),
+		Z: float64(math.Floor(float64(v.Z))),
+		W: float64(math.Floor(float64(v.W))),
+	}
+}
+
+/*
+Ceil rounds each component up.
+
+Returns:
+  - A new Vector4d with whole-number components.
+*/
+func (v Vector4d) Ceil() Vector4d {
+	return Vector4d{
+		X: float64(math.Ceil(float64(v.X))),
+		Y: float64(math.Ceil(float64(v.Y))),
+		Z: float64(math.Ceil(float64(v.Z))),
+		W: float64(math.Ceil(float64(v.W))),
+	}
+}
+
+/*
+Round rounds each component to the nearest whole number, halves away from zero.
+
+Returns:
+  - A new Vector4d with whole-number components.
+*/
+func (v Vector4d) Round() Vector4d {
+	return Vector4d{
+		X: float64(math.Round(float64(v.X))),
+		Y: float64(math.Round(float64(v.Y))),
+		Z: float64(math.Round(float64(v.Z))),
+		W: float64(math.Round(float64(v.W))),
+	}
+}
+
+/*
+AngleTo returns the unsigned angle between this vector and another.
+
+Params:
+  - other: the vector to measure the angle to.
+
+Returns:
+  - Angle in degrees in [0, 180], or 0 if either vector has length 0.
+*/
+func (v Vector4d) AngleTo(other Vector4d) float64 {
+	lengths := float64(v.Length()) * float64(other.Length())
+	if lengths == 0 {
+		return 0
+	}
+	cos := math.Max(-1, math.Min(1, float64(v.Dot(other))/lengths))
+	return float64(math.Acos(cos) * (180.0 / math.Pi))
+}
+
+
 
 
 // ------------------- Vector4i Methods -------------------
@@ -2679,8 +4611,8 @@ Params:
 Returns:
   - A new Vector4i representing the result of the addition.
 */
-func (v *Vector4i) Add(other *Vector4i) *Vector4i {
-	return &Vector4i{
+func (v Vector4i) Add(other Vector4i) Vector4i {
+	return Vector4i{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
 		Z: v.Z + other.Z,
@@ -2698,8 +4630,8 @@ Params:
 Returns:
   - A new Vector4i representing the result of the subtraction.
 */
-func (v *Vector4i) Subtract(other *Vector4i) *Vector4i {
-	return &Vector4i{
+func (v Vector4i) Subtract(other Vector4i) Vector4i {
+	return Vector4i{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 		Z: v.Z - other.Z,
@@ -2717,8 +4649,8 @@ Params:
 Returns:
   - A new Vector4i with each component multiplied.
 */
-func (v *Vector4i) Multiply(other *Vector4i) *Vector4i {
-	return &Vector4i{
+func (v Vector4i) Multiply(other Vector4i) Vector4i {
+	return Vector4i{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
 		Z: v.Z * other.Z,
@@ -2735,8 +4667,8 @@ Params:
 Returns:
   - A new Vector4i with each component scaled.
 */
-func (v *Vector4i) MultiplyScalar(scalar int32) *Vector4i {
-	return &Vector4i{
+func (v Vector4i) MultiplyScalar(scalar int32) Vector4i {
+	return Vector4i{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 		Z: v.Z * scalar,
@@ -2753,8 +4685,8 @@ Params:
 Returns:
   - A new Vector4i with each component multiplied by its corresponding scalar.
 */
-func (v *Vector4i) MultiplyScalars(x int32, y int32, z int32, w int32) *Vector4i {
-	return &Vector4i{
+func (v Vector4i) MultiplyScalars(x int32, y int32, z int32, w int32) Vector4i {
+	return Vector4i{
 		X: v.X * x,
 		Y: v.Y * y,
 		Z: v.Z * z,
@@ -2771,8 +4703,8 @@ Params:
 Returns:
   - A new Vector4i with each component divided.
 */
-func (v *Vector4i) Divide(other *Vector4i) *Vector4i {
-	return &Vector4i{
+func (v Vector4i) Divide(other Vector4i) Vector4i {
+	return Vector4i{
 		X: v.X / other.X,
 		Y: v.Y / other.Y,
 		Z: v.Z / other.Z,
@@ -2789,8 +4721,8 @@ Params:
 Returns:
   - A new Vector4i with each component divided by scalar.
 */
-func (v *Vector4i) DivideScalar(scalar int32) *Vector4i {
-	return &Vector4i{
+func (v Vector4i) DivideScalar(scalar int32) Vector4i {
+	return Vector4i{
 		X: v.X / scalar,
 		Y: v.Y / scalar,
 		Z: v.Z / scalar,
@@ -2807,8 +4739,8 @@ Params:
 Returns:
   - A new Vector4i with each component divided.
 */
-func (v *Vector4i) DivideScalars(x int32, y int32, z int32, w int32) *Vector4i {
-	return &Vector4i{
+func (v Vector4i) DivideScalars(x int32, y int32, z int32, w int32) Vector4i {
+	return Vector4i{
 		X: v.X / x,
 		Y: v.Y / y,
 		Z: v.Z / z,
@@ -2825,17 +4757,211 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *Vector4i) Equals(other *Vector4i) bool {
+func (v Vector4i) Equals(other Vector4i) bool {
 	return v.X == other.X && v.Y == other.Y && v.Z == other.Z && v.W == other.W
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *Vector4i) String() string {
+func (v Vector4i) String() string {
 	return fmt.Sprintf("Vector4i(X: %d, Y: %d, Z: %d, W: %d)", v.X, v.Y, v.Z, v.W)
 }
 
+/*
+LengthSquared returns the squared magnitude of the vector.
+
+Returns:
+  - Sum of squares of components.
+*/
+func (v Vector4i) LengthSquared() int32 {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z + v.W*v.W
+}
+
+/*
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
+
+Returns:
+  - Dot product (scalar).
+*/
+func (v Vector4i) Dot(other Vector4i) int32 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z + v.W*other.W
+}
+
+/*
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Squared distance (faster if exact distance isn't needed).
+*/
+func (v Vector4i) DistanceSquared(other Vector4i) int32 {
+	return (v.X - other.X)*(v.X - other.X) + (v.Y - other.Y)*(v.Y - other.Y) + (v.Z - other.Z)*(v.Z - other.Z) + (v.W - other.W)*(v.W - other.W)
+}
+
+/*
+Min returns the component-wise minimum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector4i with the smaller of each component.
+*/
+func (v Vector4i) Min(other Vector4i) Vector4i {
+	return Vector4i{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+		Z: min(v.Z, other.Z),
+		W: min(v.W, other.W),
+	}
+}
+
+/*
+Max returns the component-wise maximum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector4i with the larger of each component.
+*/
+func (v Vector4i) Max(other Vector4i) Vector4i {
+	return Vector4i{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+		Z: max(v.Z, other.Z),
+		W: max(v.W, other.W),
+	}
+}
+
+/*
+ToVector4f converts the vector to a Vector4f.
+
+Returns:
+  - A new Vector4f.
+*/
+func (v Vector4i) ToVector4f() Vector4f {
+	return Vector4f{
+		X: float32(v.X),
+		Y: float32(v.Y),
+		Z: float32(v.Z),
+		W: float32(v.W),
+	}
+}
+
+/*
+ToVector4d converts the vector to a Vector4d.
+
+Returns:
+  - A new Vector4d.
+*/
+func (v Vector4i) ToVector4d() Vector4d {
+	return Vector4d{
+		X: float64(v.X),
+		Y: float64(v.Y),
+		Z: float64(v.Z),
+		W: float64(v.W),
+	}
+}
+
+/*
+ToVector4u converts the vector to a Vector4u.
+Components wrap around like Go integer conversions, so negative ones become
+large, e.g. -1 becomes 4294967295.
+
+Returns:
+  - A new Vector4u.
+*/
+func (v Vector4i) ToVector4u() Vector4u {
+	return Vector4u{
+		X: uint32(v.X),
+		Y: uint32(v.Y),
+		Z: uint32(v.Z),
+		W: uint32(v.W),
+	}
+}
+
+/*
+XY returns the X and Y components as a 2D vector.
+*/
+func (v Vector4i) XY() Vector2i {
+	return Vector2i{X: v.X, Y: v.Y}
+}
+
+/*
+XYZ returns the X, Y and Z components as a 3D vector.
+*/
+func (v Vector4i) XYZ() Vector3i {
+	return Vector3i{X: v.X, Y: v.Y, Z: v.Z}
+}
+
+
+// --- Signed Vector4i Methods ---
+
+/*
+Abs returns the vector with the absolute value of each component.
+
+Returns:
+  - A new Vector4i with non-negative components.
+*/
+func (v Vector4i) Abs() Vector4i {
+	return Vector4i{
+		X: max(v.X, -v.X),
+		Y: max(v.Y, -v.Y),
+		Z: max(v.Z, -v.Z),
+		W: max(v.W, -v.W),
+	}
+}
+
+/*
+Negate returns the vector pointing in the opposite direction.
+
+Returns:
+  - A new Vector4i with each component negated.
+*/
+func (v Vector4i) Negate() Vector4i {
+	return Vector4i{
+		X: -v.X,
+		Y: -v.Y,
+		Z: -v.Z,
+		W: -v.W,
+	}
+}
+
+
+// --- Integer Vector4i Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector, computed in
+float64 so it isn't truncated.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector4i) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
+}
+
+/*
+Distance returns the Euclidean distance between two vectors, computed in float64
+so it isn't truncated.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Distance as a float64.
+*/
+func (v Vector4i) Distance(other Vector4i) float64 {
+	return math.Sqrt(float64(v.DistanceSquared(other)))
+}
+
 
 
 // ------------------- Vector4u Methods -------------------
@@ -2850,8 +4976,8 @@ Params:
 Returns:
   - A new Vector4u representing the result of the addition.
 */
-func (v *Vector4u) Add(other *Vector4u) *Vector4u {
-	return &Vector4u{
+func (v Vector4u) Add(other Vector4u) Vector4u {
+	return Vector4u{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
 		Z: v.Z + other.Z,
@@ -2869,8 +4995,8 @@ Params:
 Returns:
   - A new Vector4u representing the result of the subtraction.
 */
-func (v *Vector4u) Subtract(other *Vector4u) *Vector4u {
-	return &Vector4u{
+func (v Vector4u) Subtract(other Vector4u) Vector4u {
+	return Vector4u{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 		Z: v.Z - other.Z,
@@ -2888,8 +5014,8 @@ Params:
 Returns:
   - A new Vector4u with each component multiplied.
 */
-func (v *Vector4u) Multiply(other *Vector4u) *Vector4u {
-	return &Vector4u{
+func (v Vector4u) Multiply(other Vector4u) Vector4u {
+	return Vector4u{
 		X: v.X * other.X,
 		Y: v.Y * other.Y,
 		Z: v.Z * other.Z,
@@ -2906,8 +5032,8 @@ Params:
 Returns:
   - A new Vector4u with each component scaled.
 */
-func (v *Vector4u) MultiplyScalar(scalar uint32) *Vector4u {
-	return &Vector4u{
+func (v Vector4u) MultiplyScalar(scalar uint32) Vector4u {
+	return Vector4u{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 		Z: v.Z * scalar,
@@ -2924,8 +5050,8 @@ Params:
 Returns:
   - A new Vector4u with each component multiplied by its corresponding scalar.
 */
-func (v *Vector4u) MultiplyScalars(x uint32, y uint32, z uint32, w uint32) *Vector4u {
-	return &Vector4u{
+func (v Vector4u) MultiplyScalars(x uint32, y uint32, z uint32, w uint32) Vector4u {
+	return Vector4u{
 		X: v.X * x,
 		Y: v.Y * y,
 		Z: v.Z * z,
@@ -2942,8 +5068,8 @@ Params:
 Returns:
   - A new Vector4u with each component divided.
 */
-func (v *Vector4u) Divide(other *Vector4u) *Vector4u {
-	return &Vector4u{
+func (v Vector4u) Divide(other Vector4u) Vector4u {
+	return Vector4u{
 		X: v.X / other.X,
 		Y: v.Y / other.Y,
 		Z: v.Z / other.Z,
@@ -2960,8 +5086,8 @@ Params:
 Returns:
   - A new Vector4u with each component divided by scalar.
 */
-func (v *Vector4u) DivideScalar(scalar uint32) *Vector4u {
-	return &Vector4u{
+func (v Vector4u) DivideScalar(scalar uint32) Vector4u {
+	return Vector4u{
 		X: v.X / scalar,
 		Y: v.Y / scalar,
 		Z: v.Z / scalar,
@@ -2978,8 +5104,8 @@ Params:
 Returns:
   - A new Vector4u with each component divided.
 */
-func (v *Vector4u) DivideScalars(x uint32, y uint32, z uint32, w uint32) *Vector4u {
-	return &Vector4u{
+func (v Vector4u) DivideScalars(x uint32, y uint32, z uint32, w uint32) Vector4u {
+	return Vector4u{
 		X: v.X / x,
 		Y: v.Y / y,
 		Z: v.Z / z,
@@ -2996,15 +5122,182 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *Vector4u) Equals(other *Vector4u) bool {
+func (v Vector4u) Equals(other Vector4u) bool {
 	return v.X == other.X && v.Y == other.Y && v.Z == other.Z && v.W == other.W
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *Vector4u) String() string {
+func (v Vector4u) String() string {
 	return fmt.Sprintf("Vector4u(X: %d, Y: %d, Z: %d, W: %d)", v.X, v.Y, v.Z, v.W)
 }
 
+/*
+LengthSquared returns the squared magnitude of the vector.
+
+Returns:
+  - Sum of squares of components.
+*/
+func (v Vector4u) LengthSquared() uint32 {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z + v.W*v.W
+}
+
+/*
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
+
+Returns:
+  - Dot product (scalar).
+*/
+func (v Vector4u) Dot(other Vector4u) uint32 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z + v.W*other.W
+}
+
+/*
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Squared distance (faster if exact distance isn't needed).
+*/
+func (v Vector4u) DistanceSquared(other Vector4u) uint32 {
+	// Subtract the smaller component, so the difference doesn't wrap around
+	x := max(v.X, other.X) - min(v.X, other.X)
+	y := max(v.Y, other.Y) - min(v.Y, other.Y)
+	z := max(v.Z, other.Z) - min(v.Z, other.Z)
+	w := max(v.W, other.W) - min(v.W, other.W)
+	return x*x + y*y + z*z + w*w
+}
+
+/*
+Min returns the component-wise minimum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector4u with the smaller of each component.
+*/
+func (v Vector4u) Min(other Vector4u) Vector4u {
+	return Vector4u{
+		X: min(v.X, other.X),
+		Y: min(v.Y, other.Y),
+		Z: min(v.Z, other.Z),
+		W: min(v.W, other.W),
+	}
+}
+
+/*
+Max returns the component-wise maximum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new Vector4u with the larger of each component.
+*/
+func (v Vector4u) Max(other Vector4u) Vector4u {
+	return Vector4u{
+		X: max(v.X, other.X),
+		Y: max(v.Y, other.Y),
+		Z: max(v.Z, other.Z),
+		W: max(v.W, other.W),
+	}
+}
+
+/*
+ToVector4f converts the vector to a Vector4f.
+
+Returns:
+  - A new Vector4f.
+*/
+func (v Vector4u) ToVector4f() Vector4f {
+	return Vector4f{
+		X: float32(v.X),
+		Y: float32(v.Y),
+		Z: float32(v.Z),
+		W: float32(v.W),
+	}
+}
+
+/*
+ToVector4d converts the vector to a Vector4d.
+
+Returns:
+  - A new Vector4d.
+*/
+func (v Vector4u) ToVector4d() Vector4d {
+	return Vector4d{
+		X: float64(v.X),
+		Y: float64(v.Y),
+		Z: float64(v.Z),
+		W: float64(v.W),
+	}
+}
+
+/*
+ToVector4i converts the vector to a Vector4i.
+Components wrap around like Go integer conversions, so ones above
+2147483647 become negative.
+
+Returns:
+  - A new Vector4i.
+*/
+func (v Vector4u) ToVector4i() Vector4i {
+	return Vector4i{
+		X: int32(v.X),
+		Y: int32(v.Y),
+		Z: int32(v.Z),
+		W: int32(v.W),
+	}
+}
+
+/*
+XY returns the X and Y components as a 2D vector.
+*/
+func (v Vector4u) XY() Vector2u {
+	return Vector2u{X: v.X, Y: v.Y}
+}
+
+/*
+XYZ returns the X, Y and Z components as a 3D vector.
+*/
+func (v Vector4u) XYZ() Vector3u {
+	return Vector3u{X: v.X, Y: v.Y, Z: v.Z}
+}
+
+
+
+// --- Integer Vector4u Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector, computed in
+float64 so it isn't truncated.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v Vector4u) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
+}
+
+/*
+Distance returns the Euclidean distance between two vectors, computed in float64
+so it isn't truncated.
+
+Params:
+  - other: the vector to measure distance to.
+
+Returns:
+  - Distance as a float64.
+*/
+func (v Vector4u) Distance(other Vector4u) float64 {
+	return math.Sqrt(float64(v.DistanceSquared(other)))
+}
+
 
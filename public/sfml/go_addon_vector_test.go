@@ -0,0 +1,361 @@
+package sfml
+
+import (
+	"math"
+	"testing"
+)
+
+// Sinks keep the compiler from optimizing the benchmarked calls away.
+var (
+	vector2fSink Vector2f
+	vector2iSink Vector2i
+	vector3fSink Vector3f
+	float32Sink  float32
+
+	pointerVector2fSink *pointerVector2f
+)
+
+// pointerVector2f has the pointer receivers and results the vector methods had
+// before they switched to values, so the benchmarks can compare the two.
+type pointerVector2f Vector2f
+
+func (v *pointerVector2f) Add(other *pointerVector2f) *pointerVector2f {
+	return &pointerVector2f{X: v.X + other.X, Y: v.Y + other.Y}
+}
+
+func (v *pointerVector2f) MultiplyScalar(scalar float32) *pointerVector2f {
+	return &pointerVector2f{X: v.X * scalar, Y: v.Y * scalar}
+}
+
+func (v *pointerVector2f) DivideScalar(scalar float32) *pointerVector2f {
+	return &pointerVector2f{X: v.X / scalar, Y: v.Y / scalar}
+}
+
+func (v *pointerVector2f) Normalize() *pointerVector2f {
+	if l := float32(math.Sqrt(float64(v.X*v.X + v.Y*v.Y))); l != 0 {
+		return v.DivideScalar(l)
+	}
+	return &pointerVector2f{}
+}
+
+func (v *pointerVector2f) Lerp(other *pointerVector2f, t float32) *pointerVector2f {
+	return &pointerVector2f{X: v.X + (other.X-v.X)*t, Y: v.Y + (other.Y-v.Y)*t}
+}
+
+func TestVectorOpsDontAllocate(t *testing.T) {
+	a, b := Vector2f{X: 1, Y: 2}, Vector2f{X: 3, Y: 4}
+	allocs := testing.AllocsPerRun(100, func() {
+		vector2fSink = a.Add(b).MultiplyScalar(2).Normalize().Lerp(b, 0.5)
+	})
+	if allocs != 0 {
+		t.Errorf("vector ops allocated %v times per run, want 0", allocs)
+	}
+}
+
+func TestVectorCross(t *testing.T) {
+	tests := []struct {
+		a, b Vector3f
+		want Vector3f
+	}{
+		{a: Vector3f{X: 1}, b: Vector3f{Y: 1}, want: Vector3f{Z: 1}},
+		{a: Vector3f{Y: 1}, b: Vector3f{X: 1}, want: Vector3f{Z: -1}},
+		{a: Vector3f{Y: 1}, b: Vector3f{Z: 1}, want: Vector3f{X: 1}},
+		{a: Vector3f{X: 1, Y: 2, Z: 3}, b: Vector3f{X: 4, Y: 5, Z: 6}, want: Vector3f{X: -3, Y: 6, Z: -3}},
+		{a: Vector3f{X: 1, Y: 2, Z: 3}, b: Vector3f{X: 2, Y: 4, Z: 6}},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Cross(tt.b); got != tt.want {
+			t.Errorf("%v.Cross(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	// The 2D cross product is positive when other lies counterclockwise
+	if got := (Vector2f{X: 1}).Cross(Vector2f{Y: 1}); got != 1 {
+		t.Errorf("Vector2f Cross = %v, want 1", got)
+	}
+	if got := (Vector2i{X: 2, Y: 3}).Cross(Vector2i{X: 4, Y: 5}); got != -2 {
+		t.Errorf("Vector2i Cross = %v, want -2", got)
+	}
+	if got := (Vector3i{X: 1, Y: 2, Z: 3}).Cross(Vector3i{X: 4, Y: 5, Z: 6}); got != (Vector3i{X: -3, Y: 6, Z: -3}) {
+		t.Errorf("Vector3i Cross = %v", got)
+	}
+}
+
+func TestVectorSigned(t *testing.T) {
+	if got := (Vector2f{X: -1.5, Y: 2}).Abs(); got != (Vector2f{X: 1.5, Y: 2}) {
+		t.Errorf("Vector2f Abs = %v", got)
+	}
+	if got := (Vector3i{X: -3, Y: 0, Z: 4}).Abs(); got != (Vector3i{X: 3, Y: 0, Z: 4}) {
+		t.Errorf("Vector3i Abs = %v", got)
+	}
+	if got := (Vector4d{X: -1, Y: 2, Z: -3, W: 4}).Negate(); got != (Vector4d{X: 1, Y: -2, Z: 3, W: -4}) {
+		t.Errorf("Vector4d Negate = %v", got)
+	}
+
+	tests := []struct {
+		v, want Vector2f
+	}{
+		{v: Vector2f{X: 1}, want: Vector2f{Y: 1}},
+		{v: Vector2f{Y: 1}, want: Vector2f{X: -1}},
+		{v: Vector2f{X: 3, Y: -2}, want: Vector2f{X: 2, Y: 3}},
+	}
+	for _, tt := range tests {
+		got := tt.v.Perpendicular()
+		if got != tt.want {
+			t.Errorf("%v.Perpendicular() = %v, want %v", tt.v, got, tt.want)
+		}
+		if tt.v.Dot(got) != 0 || tt.v.Cross(got) <= 0 {
+			t.Errorf("%v.Perpendicular() = %v is not counterclockwise at 90 degrees", tt.v, got)
+		}
+	}
+	if got := (Vector2i{X: 3, Y: -2}).Perpendicular(); got != (Vector2i{X: 2, Y: 3}) {
+		t.Errorf("Vector2i Perpendicular = %v", got)
+	}
+}
+
+func TestVectorMinMax(t *testing.T) {
+	a, b := Vector3f{X: 1, Y: -5, Z: 3}, Vector3f{X: 2, Y: -6, Z: 3}
+	if got := a.Min(b); got != (Vector3f{X: 1, Y: -6, Z: 3}) {
+		t.Errorf("%v.Min(%v) = %v", a, b, got)
+	}
+	if got := a.Max(b); got != (Vector3f{X: 2, Y: -5, Z: 3}) {
+		t.Errorf("%v.Max(%v) = %v", a, b, got)
+	}
+	u, w := Vector2u{X: 0, Y: 7}, Vector2u{X: 4294967295, Y: 1}
+	if got := u.Min(w); got != (Vector2u{X: 0, Y: 1}) {
+		t.Errorf("%v.Min(%v) = %v", u, w, got)
+	}
+	if got := u.Max(w); got != (Vector2u{X: 4294967295, Y: 7}) {
+		t.Errorf("%v.Max(%v) = %v", u, w, got)
+	}
+}
+
+func TestVectorRounding(t *testing.T) {
+	tests := []struct {
+		v                  Vector2f
+		floor, ceil, round Vector2f
+	}{
+		{v: Vector2f{X: 1.2, Y: 1.7}, floor: Vector2f{X: 1, Y: 1}, ceil: Vector2f{X: 2, Y: 2}, round: Vector2f{X: 1, Y: 2}},
+		{v: Vector2f{X: -1.2, Y: -1.7}, floor: Vector2f{X: -2, Y: -2}, ceil: Vector2f{X: -1, Y: -1}, round: Vector2f{X: -1, Y: -2}},
+		{v: Vector2f{X: 2.5, Y: -2.5}, floor: Vector2f{X: 2, Y: -3}, ceil: Vector2f{X: 3, Y: -2}, round: Vector2f{X: 3, Y: -3}},
+		{v: Vector2f{X: 4, Y: -4}, floor: Vector2f{X: 4, Y: -4}, ceil: Vector2f{X: 4, Y: -4}, round: Vector2f{X: 4, Y: -4}},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Floor(); got != tt.floor {
+			t.Errorf("%v.Floor() = %v, want %v", tt.v, got, tt.floor)
+		}
+		if got := tt.v.Ceil(); got != tt.ceil {
+			t.Errorf("%v.Ceil() = %v, want %v", tt.v, got, tt.ceil)
+		}
+		if got := tt.v.Round(); got != tt.round {
+			t.Errorf("%v.Round() = %v, want %v", tt.v, got, tt.round)
+		}
+	}
+	if got := (Vector3d{X: 0.5, Y: -0.5, Z: 1.49}).Round(); got != (Vector3d{X: 1, Y: -1, Z: 1}) {
+		t.Errorf("Vector3d Round = %v", got)
+	}
+}
+
+func TestVectorAngles(t *testing.T) {
+	const epsilon = 1e-4
+	angles := []struct {
+		v    Vector2f
+		want float32
+	}{
+		{v: Vector2f{X: 1}, want: 0},
+		{v: Vector2f{Y: 1}, want: 90},
+		{v: Vector2f{X: -1}, want: 180},
+		{v: Vector2f{Y: -1}, want: -90},
+		{v: Vector2f{X: 1, Y: 1}, want: 45},
+		{v: Vector2f{}, want: 0},
+	}
+	for _, tt := range angles {
+		if got := tt.v.Angle(); math.Abs(float64(got-tt.want)) > epsilon {
+			t.Errorf("%v.Angle() = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+	// Rotate turns by the same convention
+	if got := (Vector2f{X: 1}).Rotate(30).Angle(); math.Abs(float64(got-30)) > epsilon {
+		t.Errorf("Angle after Rotate(30) = %v", got)
+	}
+
+	between := []struct {
+		a, b Vector2f
+		want float32
+	}{
+		{a: Vector2f{X: 1}, b: Vector2f{X: 3}, want: 0},
+		{a: Vector2f{X: 1}, b: Vector2f{Y: 2}, want: 90},
+		{a: Vector2f{X: 1}, b: Vector2f{Y: -2}, want: 90},
+		{a: Vector2f{X: 1}, b: Vector2f{X: -1}, want: 180},
+		{a: Vector2f{X: 1}, b: Vector2f{X: 1, Y: 1}, want: 45},
+		{a: Vector2f{}, b: Vector2f{X: 1}, want: 0},
+	}
+	for _, tt := range between {
+		if got := tt.a.AngleTo(tt.b); math.Abs(float64(got-tt.want)) > epsilon {
+			t.Errorf("%v.AngleTo(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+	if got := (Vector3d{X: 1}).AngleTo(Vector3d{Z: 5}); math.Abs(got-90) > epsilon {
+		t.Errorf("Vector3d AngleTo = %v, want 90", got)
+	}
+}
+
+func TestVectorSwizzles(t *testing.T) {
+	v3 := Vector3f{X: 1, Y: 2, Z: 3}
+	if got := v3.XY(); got != (Vector2f{X: 1, Y: 2}) {
+		t.Errorf("XY = %v", got)
+	}
+	if got := v3.XZ(); got != (Vector2f{X: 1, Y: 3}) {
+		t.Errorf("XZ = %v", got)
+	}
+	if got := v3.YZ(); got != (Vector2f{X: 2, Y: 3}) {
+		t.Errorf("YZ = %v", got)
+	}
+	v4 := Vector4i{X: 1, Y: 2, Z: 3, W: 4}
+	if got := v4.XY(); got != (Vector2i{X: 1, Y: 2}) {
+		t.Errorf("Vector4i XY = %v", got)
+	}
+	if got := v4.XYZ(); got != (Vector3i{X: 1, Y: 2, Z: 3}) {
+		t.Errorf("Vector4i XYZ = %v", got)
+	}
+}
+
+func TestIntegerVectorLength(t *testing.T) {
+	if got := (Vector2i{X: 3, Y: -4}).Length(); got != 5 {
+		t.Errorf("Vector2i Length = %v, want 5", got)
+	}
+	// Not truncated to an integer
+	if got := (Vector3i{X: 1, Y: 1, Z: 0}).Length(); got != math.Sqrt2 {
+		t.Errorf("Vector3i Length = %v, want %v", got, math.Sqrt2)
+	}
+	a, b := Vector2u{X: 1, Y: 1}, Vector2u{X: 4, Y: 5}
+	// Unsigned components don't wrap, whichever vector is larger
+	if got := a.Distance(b); got != 5 {
+		t.Errorf("%v.Distance(%v) = %v, want 5", a, b, got)
+	}
+	if got := b.Distance(a); got != 5 {
+		t.Errorf("%v.Distance(%v) = %v, want 5", b, a, got)
+	}
+	if got := b.DistanceSquared(a); got != 25 {
+		t.Errorf("%v.DistanceSquared(%v) = %v, want 25", b, a, got)
+	}
+	if got := (Vector2i{X: -1, Y: -1}).Distance(Vector2i{X: 1, Y: 1}); got != 2*math.Sqrt2 {
+		t.Errorf("Vector2i Distance = %v, want %v", got, 2*math.Sqrt2)
+	}
+}
+
+func TestVectorConversions(t *testing.T) {
+	if got := (Vector2f{X: 1.9, Y: -1.9}).ToVector2i(); got != (Vector2i{X: 1, Y: -1}) {
+		t.Errorf("ToVector2i truncated to %v, want {1, -1}", got)
+	}
+	// Negative floats clamp to 0 instead of depending on the platform
+	if got := (Vector2f{X: -3.5, Y: 2.7}).ToVector2u(); got != (Vector2u{X: 0, Y: 2}) {
+		t.Errorf("Vector2f ToVector2u = %v, want {0, 2}", got)
+	}
+	if got := (Vector3d{X: -1e9, Y: -0.5, Z: 7}).ToVector3u(); got != (Vector3u{X: 0, Y: 0, Z: 7}) {
+		t.Errorf("Vector3d ToVector3u = %v, want {0, 0, 7}", got)
+	}
+	if got := (Vector4f{X: -1, Y: 1, Z: -2, W: 2}).ToVector4u(); got != (Vector4u{X: 0, Y: 1, Z: 0, W: 2}) {
+		t.Errorf("Vector4f ToVector4u = %v", got)
+	}
+	// Integers wrap around between signed and unsigned
+	if got := (Vector2i{X: -1, Y: 2}).ToVector2u(); got != (Vector2u{X: 4294967295, Y: 2}) {
+		t.Errorf("Vector2i ToVector2u = %v, want {4294967295, 2}", got)
+	}
+	if got := (Vector2u{X: 4294967295, Y: 2147483648}).ToVector2i(); got != (Vector2i{X: -1, Y: -2147483648}) {
+		t.Errorf("Vector2u ToVector2i = %v, want {-1, -2147483648}", got)
+	}
+	if got := (Vector3i{X: 3, Y: -4, Z: 5}).ToVector3f(); got != (Vector3f{X: 3, Y: -4, Z: 5}) {
+		t.Errorf("Vector3i ToVector3f = %v", got)
+	}
+	if got := (Vector2u{X: 4294967295}).ToVector2d(); got != (Vector2d{X: 4294967295}) {
+		t.Errorf("Vector2u ToVector2d = %v", got)
+	}
+	if got := (Vector2d{X: 0.1, Y: -2}).ToVector2f(); got != (Vector2f{X: 0.1, Y: -2}) {
+		t.Errorf("Vector2d ToVector2f = %v", got)
+	}
+}
+
+// The results are kept in a sink of their own type, as a caller storing them
+// would. The pointer result escapes and allocates, while the values stay on the
+// stack. Steps that aren't inlined allocate too, which this chain doesn't show.
+func BenchmarkVector2fReceivers(b *testing.B) {
+	b.Run("value", func(b *testing.B) {
+		b.ReportAllocs()
+		v, other := Vector2f{X: 1, Y: 2}, Vector2f{X: 3, Y: 4}
+		for b.Loop() {
+			vector2fSink = v.Add(other).MultiplyScalar(2).Normalize().Lerp(other, 0.5)
+		}
+	})
+	b.Run("pointer", func(b *testing.B) {
+		b.ReportAllocs()
+		v, other := &pointerVector2f{X: 1, Y: 2}, &pointerVector2f{X: 3, Y: 4}
+		for b.Loop() {
+			pointerVector2fSink = v.Add(other).MultiplyScalar(2).Normalize().Lerp(other, 0.5)
+		}
+	})
+}
+
+func BenchmarkVector2fAdd(b *testing.B) {
+	b.ReportAllocs()
+	v, other := Vector2f{X: 1, Y: 2}, Vector2f{X: 3, Y: 4}
+	for b.Loop() {
+		vector2fSink = v.Add(other)
+	}
+}
+
+func BenchmarkVector2fMultiplyScalar(b *testing.B) {
+	b.ReportAllocs()
+	v := Vector2f{X: 1, Y: 2}
+	for b.Loop() {
+		vector2fSink = v.MultiplyScalar(1.5)
+	}
+}
+
+func BenchmarkVector2fNormalize(b *testing.B) {
+	b.ReportAllocs()
+	v := Vector2f{X: 3, Y: 4}
+	for b.Loop() {
+		vector2fSink = v.Normalize()
+	}
+}
+
+func BenchmarkVector2fLerp(b *testing.B) {
+	b.ReportAllocs()
+	v, other := Vector2f{X: 1, Y: 2}, Vector2f{X: 3, Y: 4}
+	for b.Loop() {
+		vector2fSink = v.Lerp(other, 0.25)
+	}
+}
+
+func BenchmarkVector2fRotate(b *testing.B) {
+	b.ReportAllocs()
+	v := Vector2f{X: 1, Y: 2}
+	for b.Loop() {
+		vector2fSink = v.Rotate(0.5)
+	}
+}
+
+func BenchmarkVector2fDot(b *testing.B) {
+	b.ReportAllocs()
+	v, other := Vector2f{X: 1, Y: 2}, Vector2f{X: 3, Y: 4}
+	for b.Loop() {
+		float32Sink = v.Dot(other)
+	}
+}
+
+func BenchmarkVector2iAdd(b *testing.B) {
+	b.ReportAllocs()
+	v, other := Vector2i{X: 1, Y: 2}, Vector2i{X: 3, Y: 4}
+	for b.Loop() {
+		vector2iSink = v.Add(other)
+	}
+}
+
+func BenchmarkVector3fCross(b *testing.B) {
+	b.ReportAllocs()
+	v, other := Vector3f{X: 1, Y: 2, Z: 3}, Vector3f{X: 4, Y: 5, Z: 6}
+	for b.Loop() {
+		vector3fSink = v.Cross(other)
+	}
+}
@@ -72,32 +72,32 @@ package sfml
 // #include <SFML/Window/WindowHandle.h>
 // #cgo LDFLAGS: -lcsfml-graphics -lcsfml-window -lcsfml-system -lsfml-graphics -lsfml-window -lsfml-system -lX11 -lstdc++ -lm -lGL -ludev -lXrandr -lfreetype -lXcursor
 //
-// static inline sfEventType get_sfMouseWheelEvent_type(const sfMouseWheelEvent* a) {
+// static inline sfEventType get_sfKeyEvent_type(const sfKeyEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfMouseWheelEvent_type(sfMouseWheelEvent* a, sfEventType type) {
+// static inline void set_sfKeyEvent_type(sfKeyEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfSizeEvent_type(const sfSizeEvent* a) {
+// static inline sfEventType get_sfMouseMoveEvent_type(const sfMouseMoveEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfSizeEvent_type(sfSizeEvent* a, sfEventType type) {
+// static inline void set_sfMouseMoveEvent_type(sfMouseMoveEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfKeyEvent_type(const sfKeyEvent* a) {
+// static inline sfEventType get_sfSizeEvent_type(const sfSizeEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfKeyEvent_type(sfKeyEvent* a, sfEventType type) {
+// static inline void set_sfSizeEvent_type(sfSizeEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
@@ -112,52 +112,52 @@ package sfml
 // }
 //
 //
-// static inline sfEventType get_sfMouseMoveEvent_type(const sfMouseMoveEvent* a) {
+// static inline sfEventType get_sfMouseButtonEvent_type(const sfMouseButtonEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfMouseMoveEvent_type(sfMouseMoveEvent* a, sfEventType type) {
+// static inline void set_sfMouseButtonEvent_type(sfMouseButtonEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfTouchEvent_type(const sfTouchEvent* a) {
+// static inline sfEventType get_sfMouseWheelEvent_type(const sfMouseWheelEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfTouchEvent_type(sfTouchEvent* a, sfEventType type) {
+// static inline void set_sfMouseWheelEvent_type(sfMouseWheelEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfMouseButtonEvent_type(const sfMouseButtonEvent* a) {
+// static inline sfEventType get_sfMouseWheelScrollEvent_type(const sfMouseWheelScrollEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfMouseButtonEvent_type(sfMouseButtonEvent* a, sfEventType type) {
+// static inline void set_sfMouseWheelScrollEvent_type(sfMouseWheelScrollEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfMouseWheelScrollEvent_type(const sfMouseWheelScrollEvent* a) {
+// static inline sfEventType get_sfSensorEvent_type(const sfSensorEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfMouseWheelScrollEvent_type(sfMouseWheelScrollEvent* a, sfEventType type) {
+// static inline void set_sfSensorEvent_type(sfSensorEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfSensorEvent_type(const sfSensorEvent* a) {
+// static inline sfEventType get_sfTouchEvent_type(const sfTouchEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfSensorEvent_type(sfSensorEvent* a, sfEventType type) {
+// static inline void set_sfTouchEvent_type(sfTouchEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
@@ -176,49 +176,95 @@ package sfml
 //     return a->size;
 // }
 //
+//
+// static inline void set_sfSizeEvent_in_sfEvent_union(sfEvent* a, sfSizeEvent value) {
+//     a->size = value;
+// }
+//
 // 
 // static inline sfKeyEvent get_sfKeyEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->key;
 // }
 //
+//
+// static inline void set_sfKeyEvent_in_sfEvent_union(sfEvent* a, sfKeyEvent value) {
+//     a->key = value;
+// }
+//
 // 
 // static inline sfTextEvent get_sfTextEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->text;
 // }
 //
+//
+// static inline void set_sfTextEvent_in_sfEvent_union(sfEvent* a, sfTextEvent value) {
+//     a->text = value;
+// }
+//
 // 
 // static inline sfMouseMoveEvent get_sfMouseMoveEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->mouseMove;
 // }
 //
+//
+// static inline void set_sfMouseMoveEvent_in_sfEvent_union(sfEvent* a, sfMouseMoveEvent value) {
+//     a->mouseMove = value;
+// }
+//
 // 
 // static inline sfMouseButtonEvent get_sfMouseButtonEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->mouseButton;
 // }
 //
+//
+// static inline void set_sfMouseButtonEvent_in_sfEvent_union(sfEvent* a, sfMouseButtonEvent value) {
+//     a->mouseButton = value;
+// }
+//
 // 
 // static inline sfMouseWheelEvent get_sfMouseWheelEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->mouseWheel;
 // }
 //
+//
+// static inline void set_sfMouseWheelEvent_in_sfEvent_union(sfEvent* a, sfMouseWheelEvent value) {
+//     a->mouseWheel = value;
+// }
+//
 // 
 // static inline sfMouseWheelScrollEvent get_sfMouseWheelScrollEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->mouseWheelScroll;
 // }
 //
+//
+// static inline void set_sfMouseWheelScrollEvent_in_sfEvent_union(sfEvent* a, sfMouseWheelScrollEvent value) {
+//     a->mouseWheelScroll = value;
+// }
+//
 // 
 // static inline sfTouchEvent get_sfTouchEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->touch;
 // }
 //
+//
+// static inline void set_sfTouchEvent_in_sfEvent_union(sfEvent* a, sfTouchEvent value) {
+//     a->touch = value;
+// }
+//
 // 
 // static inline sfSensorEvent get_sfSensorEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->sensor;
 // }
 //
 //
+// static inline void set_sfSensorEvent_in_sfEvent_union(sfEvent* a, sfSensorEvent value) {
+//     a->sensor = value;
+// }
+//
+//
 import "C"
 import "unsafe"
+import "time"
 
 func NewBuffer() *Buffer {
 	funcRes0 := C.sfBuffer_create()
@@ -460,20 +506,25 @@ func (c *CircleShape) SetTextureRect(rect IntRect) {
 }
 
 func ClipboardGetString() string {
+	assertMainThread()
 	return C.GoString(C.sfClipboard_getString())
 }
 
-func ClipboardGetUnicodeString() *uint32 {
-	return (*uint32)(C.sfClipboard_getUnicodeString())
+func ClipboardGetUnicodeString() string {
+	assertMainThread()
+	return utf32ToString(unsafe.Pointer(C.sfClipboard_getUnicodeString()))
 }
 
 func ClipboardSetString(text string) {
+	assertMainThread()
 	var0 := C.CString(text)
 	C.sfClipboard_setString(var0)
 }
 
-func ClipboardSetUnicodeString(text *uint32) {
-	var0 := (*C.sfUint32)(text)
+func ClipboardSetUnicodeString(text string) {
+	assertMainThread()
+	var0 := (*C.sfUint32)(stringToUTF32(text))
+	defer C.free(unsafe.Pointer(var0))
 	C.sfClipboard_setUnicodeString(var0)
 }
 
@@ -494,17 +545,17 @@ func (c *Clock) Free() {
 	C.sfClock_destroy(var0)
 }
 
-func (c *Clock) ElapsedTime() *Time {
+func (c *Clock) ElapsedTime() time.Duration {
 	var0 := c.ToC()
 	funcRes0 := C.sfClock_getElapsedTime(var0)
-	res := NewTimeFromC(funcRes0)
+	res := sfTimeToDuration(funcRes0)
 	return res
 }
 
-func (c *Clock) Restart() *Time {
+func (c *Clock) Restart() time.Duration {
 	var0 := c.ToC()
 	funcRes0 := C.sfClock_restart(var0)
-	res := NewTimeFromC(funcRes0)
+	res := sfTimeToDuration(funcRes0)
 	return res
 }
 
@@ -563,20 +614,24 @@ func (c *Color) ToInteger() uint32 {
 }
 
 func NewContext() *Context {
+	assertMainThread()
 	funcRes0 := C.sfContext_create()
 	return NewContextFromC(funcRes0)
 }
 
 func (c *Context) Free() {
+	assertMainThread()
 	var0 := c.ToC()
 	C.sfContext_destroy(var0)
 }
 
 func ContextGetActiveContextId() uint64 {
+	assertMainThread()
 	return uint64(C.sfContext_getActiveContextId())
 }
 
 func (c *Context) Settings() *ContextSettings {
+	assertMainThread()
 	var0 := c.ToC()
 	funcRes0 := C.sfContext_getSettings(var0)
 	res := NewContextSettingsFromC(funcRes0)
@@ -584,11 +639,13 @@ func (c *Context) Settings() *ContextSettings {
 }
 
 func ContextIsExtensionAvailable(name string) bool {
+	assertMainThread()
 	var0 := C.CString(name)
 	return sfBoolToBool(C.sfContext_isExtensionAvailable(var0))
 }
 
 func (c *Context) SetActive(active bool) bool {
+	assertMainThread()
 	var0 := c.ToC()
 	var1 := boolToSfBool(active)
 	funcRes0 := C.sfContext_setActive(var0, var1)
@@ -806,6 +863,7 @@ func (c *ConvexShape) SetTextureRect(rect IntRect) {
 }
 
 func NewCursorFromPixels(pixels *uint8, size Vector2u, hotspot Vector2u) *Cursor {
+	assertMainThread()
 	var0 := (*C.sfUint8)(pixels)
 	var1 := size.ToC()
 	var2 := hotspot.ToC()
@@ -814,12 +872,14 @@ func NewCursorFromPixels(pixels *uint8, size Vector2u, hotspot Vector2u) *Cursor
 }
 
 func NewCursorFromSystem(cursorType CursorType) *Cursor {
+	assertMainThread()
 	var0 := C.sfCursorType(cursorType)
 	funcRes0 := C.sfCursor_createFromSystem(var0)
 	return NewCursorFromC(funcRes0)
 }
 
 func (c *Cursor) Free() {
+	assertMainThread()
 	var0 := c.ToC()
 	C.sfCursor_destroy(var0)
 }
@@ -1142,73 +1202,104 @@ func (i *IntRect) Intersects(rect2 *IntRect) (*IntRect, bool) {
 }
 
 func KeyboardDelocalize(key KeyCode) Scancode {
+	assertMainThread()
 	var0 := C.sfKeyCode(key)
 	return Scancode(C.sfKeyboard_delocalize(var0))
 }
 
 func KeyboardGetDescription(code Scancode) string {
+	assertMainThread()
 	var0 := C.sfScancode(code)
 	return C.GoString(C.sfKeyboard_getDescription(var0))
 }
 
 func KeyboardIsKeyPressed(key KeyCode) bool {
+	assertMainThread()
 	var0 := C.sfKeyCode(key)
 	return sfBoolToBool(C.sfKeyboard_isKeyPressed(var0))
 }
 
 func KeyboardIsScancodePressed(code Scancode) bool {
+	assertMainThread()
 	var0 := C.sfScancode(code)
 	return sfBoolToBool(C.sfKeyboard_isScancodePressed(var0))
 }
 
 func KeyboardLocalize(code Scancode) KeyCode {
+	assertMainThread()
 	var0 := C.sfScancode(code)
 	return KeyCode(C.sfKeyboard_localize(var0))
 }
 
 func KeyboardSetVirtualKeyboardVisible(visible bool) {
+	assertMainThread()
 	var0 := boolToSfBool(visible)
 	C.sfKeyboard_setVirtualKeyboardVisible(var0)
 }
 
-func MouseGetPosition(relativeTo *Window) *Vector2i {
-	var0 := relativeTo.ToC()
+func mouseGetPositionWindow(relativeTo *Window) *Vector2i {
+	assertMainThread()
+	var var0 *C.sfWindow = nil
+	if relativeTo != nil {
+	  var0 = relativeTo.ToC()
+	}
 	funcRes0 := C.sfMouse_getPosition(var0)
 	return NewVector2iFromC(funcRes0)
 }
 
-func MouseGetPositionRenderWindow(relativeTo *RenderWindow) *Vector2i {
-	var0 := relativeTo.ToC()
+func mouseGetPositionRenderWindow(relativeTo *RenderWindow) *Vector2i {
+	assertMainThread()
+	var var0 *C.sfRenderWindow = nil
+	if relativeTo != nil {
+	  var0 = relativeTo.ToC()
+	}
 	funcRes0 := C.sfMouse_getPositionRenderWindow(var0)
 	return NewVector2iFromC(funcRes0)
 }
 
-func MouseGetPositionWindowBase(relativeTo *WindowBase) *Vector2i {
-	var0 := relativeTo.ToC()
+func mouseGetPositionWindowBase(relativeTo *WindowBase) *Vector2i {
+	assertMainThread()
+	var var0 *C.sfWindowBase = nil
+	if relativeTo != nil {
+	  var0 = relativeTo.ToC()
+	}
 	funcRes0 := C.sfMouse_getPositionWindowBase(var0)
 	return NewVector2iFromC(funcRes0)
 }
 
 func MouseIsButtonPressed(button MouseButton) bool {
+	assertMainThread()
 	var0 := C.sfMouseButton(button)
 	return sfBoolToBool(C.sfMouse_isButtonPressed(var0))
 }
 
-func MouseSetPosition(position Vector2i, relativeTo *Window) {
+func mouseSetPositionWindow(position Vector2i, relativeTo *Window) {
+	assertMainThread()
 	var0 := position.ToC()
-	var1 := relativeTo.ToC()
+	var var1 *C.sfWindow = nil
+	if relativeTo != nil {
+	  var1 = relativeTo.ToC()
+	}
 	C.sfMouse_setPosition(var0, var1)
 }
 
-func MouseSetPositionRenderWindow(position Vector2i, relativeTo *RenderWindow) {
+func mouseSetPositionRenderWindow(position Vector2i, relativeTo *RenderWindow) {
+	assertMainThread()
 	var0 := position.ToC()
-	var1 := relativeTo.ToC()
+	var var1 *C.sfRenderWindow = nil
+	if relativeTo != nil {
+	  var1 = relativeTo.ToC()
+	}
 	C.sfMouse_setPositionRenderWindow(var0, var1)
 }
 
-func MouseSetPositionWindowBase(position Vector2i, relativeTo *WindowBase) {
+func mouseSetPositionWindowBase(position Vector2i, relativeTo *WindowBase) {
+	assertMainThread()
 	var0 := position.ToC()
-	var1 := relativeTo.ToC()
+	var var1 *C.sfWindowBase = nil
+	if relativeTo != nil {
+	  var1 = relativeTo.ToC()
+	}
 	C.sfMouse_setPositionWindowBase(var0, var1)
 }
 
@@ -1638,17 +1729,17 @@ func (r *RenderTexture) MapPixelToCoords(point Vector2i, view *View) *Vector2f {
 	return res
 }
 
-func (r *RenderTexture) PopGlsTates() {
+func (r *RenderTexture) PopGLStates() {
 	var0 := r.ToC()
 	C.sfRenderTexture_popGLStates(var0)
 }
 
-func (r *RenderTexture) PushGlsTates() {
+func (r *RenderTexture) PushGLStates() {
 	var0 := r.ToC()
 	C.sfRenderTexture_pushGLStates(var0)
 }
 
-func (r *RenderTexture) ResetGlsTates() {
+func (r *RenderTexture) ResetGLStates() {
 	var0 := r.ToC()
 	C.sfRenderTexture_resetGLStates(var0)
 }
@@ -1680,6 +1771,7 @@ func (r *RenderTexture) SetView(view *View) {
 }
 
 func (r *RenderWindow) Capture() *Image {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_capture(var0)
 	res := NewImageFromC(funcRes0)
@@ -1687,17 +1779,20 @@ func (r *RenderWindow) Capture() *Image {
 }
 
 func (r *RenderWindow) Clear(color Color) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := color.ToC()
 	C.sfRenderWindow_clear(var0, var1)
 }
 
 func (r *RenderWindow) Close() {
+	assertMainThread()
 	var0 := r.ToC()
 	C.sfRenderWindow_close(var0)
 }
 
-func NewRenderWindow(mode VideoMode, title string, style uint32, settings *ContextSettings) *RenderWindow {
+func NewRenderWindow(mode VideoMode, title string, style WindowStyle, settings *ContextSettings) *RenderWindow {
+	assertMainThread()
 	var0 := mode.ToC()
 	var1 := C.CString(title)
 	var2 := C.sfUint32(style)
@@ -1707,32 +1802,38 @@ func NewRenderWindow(mode VideoMode, title string, style uint32, settings *Conte
 }
 
 func NewRenderWindowFromHandle(handle uintptr, settings *ContextSettings) *RenderWindow {
+	assertMainThread()
 	var0 := C.sfWindowHandle(handle)
 	var1 := settings.ToC()
 	funcRes0 := C.sfRenderWindow_createFromHandle(var0, &var1)
 	return NewRenderWindowFromC(funcRes0)
 }
 
-func NewRenderWindowUnicode(mode VideoMode, title *uint32, style uint32, settings *ContextSettings) *RenderWindow {
+func NewRenderWindowUnicode(mode VideoMode, title string, style WindowStyle, settings *ContextSettings) *RenderWindow {
+	assertMainThread()
 	var0 := mode.ToC()
-	var1 := (*C.sfUint32)(title)
-	var2 := C.sfUint32(style)
-	var3 := settings.ToC()
-	funcRes0 := C.sfRenderWindow_createUnicode(var0, var1, var2, &var3)
+	var1 := (*C.sfUint32)(stringToUTF32(title))
+	defer C.free(unsafe.Pointer(var1))
+	var3 := C.sfUint32(style)
+	var4 := settings.ToC()
+	funcRes0 := C.sfRenderWindow_createUnicode(var0, var1, var3, &var4)
 	return NewRenderWindowFromC(funcRes0)
 }
 
 func (r *RenderWindow) Free() {
+	assertMainThread()
 	var0 := r.ToC()
 	C.sfRenderWindow_destroy(var0)
 }
 
 func (r *RenderWindow) Display() {
+	assertMainThread()
 	var0 := r.ToC()
 	C.sfRenderWindow_display(var0)
 }
 
 func (r *RenderWindow) DrawCircleShape(object *CircleShape, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := object.ToC()
 	var2 := states.ToC()
@@ -1740,6 +1841,7 @@ func (r *RenderWindow) DrawCircleShape(object *CircleShape, states *RenderStates
 }
 
 func (r *RenderWindow) DrawConvexShape(object *ConvexShape, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := object.ToC()
 	var2 := states.ToC()
@@ -1747,6 +1849,7 @@ func (r *RenderWindow) DrawConvexShape(object *ConvexShape, states *RenderStates
 }
 
 func (r *RenderWindow) DrawPrimitives(vertices *Vertex, vertexCount uint64, primitiveType PrimitiveType, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := vertices.ToC()
 	var2 := C.size_t(vertexCount)
@@ -1756,6 +1859,7 @@ func (r *RenderWindow) DrawPrimitives(vertices *Vertex, vertexCount uint64, prim
 }
 
 func (r *RenderWindow) DrawRectangleShape(object *RectangleShape, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := object.ToC()
 	var2 := states.ToC()
@@ -1763,6 +1867,7 @@ func (r *RenderWindow) DrawRectangleShape(object *RectangleShape, states *Render
 }
 
 func (r *RenderWindow) DrawShape(object *Shape, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := object.ToC()
 	var2 := states.ToC()
@@ -1770,6 +1875,7 @@ func (r *RenderWindow) DrawShape(object *Shape, states *RenderStates) {
 }
 
 func (r *RenderWindow) DrawSprite(object *Sprite, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := object.ToC()
 	var2 := states.ToC()
@@ -1777,6 +1883,7 @@ func (r *RenderWindow) DrawSprite(object *Sprite, states *RenderStates) {
 }
 
 func (r *RenderWindow) DrawText(object *Text, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := object.ToC()
 	var2 := states.ToC()
@@ -1784,6 +1891,7 @@ func (r *RenderWindow) DrawText(object *Text, states *RenderStates) {
 }
 
 func (r *RenderWindow) DrawVertexArray(object *VertexArray, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := object.ToC()
 	var2 := states.ToC()
@@ -1791,6 +1899,7 @@ func (r *RenderWindow) DrawVertexArray(object *VertexArray, states *RenderStates
 }
 
 func (r *RenderWindow) DrawVertexBuffer(object *VertexBuffer, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := object.ToC()
 	var2 := states.ToC()
@@ -1798,6 +1907,7 @@ func (r *RenderWindow) DrawVertexBuffer(object *VertexBuffer, states *RenderStat
 }
 
 func (r *RenderWindow) DrawVertexBufferRange(object *VertexBuffer, firstVertex uint64, vertexCount uint64, states *RenderStates) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := object.ToC()
 	var2 := C.size_t(firstVertex)
@@ -1807,6 +1917,7 @@ func (r *RenderWindow) DrawVertexBufferRange(object *VertexBuffer, firstVertex u
 }
 
 func (r *RenderWindow) DefaultView() *View {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_getDefaultView(var0)
 	res := NewViewFromC(funcRes0)
@@ -1814,6 +1925,7 @@ func (r *RenderWindow) DefaultView() *View {
 }
 
 func (r *RenderWindow) Position() *Vector2i {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_getPosition(var0)
 	res := NewVector2iFromC(funcRes0)
@@ -1821,6 +1933,7 @@ func (r *RenderWindow) Position() *Vector2i {
 }
 
 func (r *RenderWindow) Settings() *ContextSettings {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_getSettings(var0)
 	res := NewContextSettingsFromC(funcRes0)
@@ -1828,6 +1941,7 @@ func (r *RenderWindow) Settings() *ContextSettings {
 }
 
 func (r *RenderWindow) Size() *Vector2u {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_getSize(var0)
 	res := NewVector2uFromC(funcRes0)
@@ -1835,6 +1949,7 @@ func (r *RenderWindow) Size() *Vector2u {
 }
 
 func (r *RenderWindow) SystemHandle() uintptr {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_getSystemHandle(var0)
 	res := uintptr(funcRes0)
@@ -1842,6 +1957,7 @@ func (r *RenderWindow) SystemHandle() uintptr {
 }
 
 func (r *RenderWindow) View() *View {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_getView(var0)
 	res := NewViewFromC(funcRes0)
@@ -1849,6 +1965,7 @@ func (r *RenderWindow) View() *View {
 }
 
 func (r *RenderWindow) Viewport(view *View) *IntRect {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := view.ToC()
 	funcRes0 := C.sfRenderWindow_getViewport(var0, var1)
@@ -1857,6 +1974,7 @@ func (r *RenderWindow) Viewport(view *View) *IntRect {
 }
 
 func (r *RenderWindow) HasFocus() bool {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_hasFocus(var0)
 	res := sfBoolToBool(funcRes0)
@@ -1864,6 +1982,7 @@ func (r *RenderWindow) HasFocus() bool {
 }
 
 func (r *RenderWindow) IsOpen() bool {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_isOpen(var0)
 	res := sfBoolToBool(funcRes0)
@@ -1871,6 +1990,7 @@ func (r *RenderWindow) IsOpen() bool {
 }
 
 func (r *RenderWindow) IsSrgb() bool {
+	assertMainThread()
 	var0 := r.ToC()
 	funcRes0 := C.sfRenderWindow_isSrgb(var0)
 	res := sfBoolToBool(funcRes0)
@@ -1878,6 +1998,7 @@ func (r *RenderWindow) IsSrgb() bool {
 }
 
 func (r *RenderWindow) MapCoordsToPixel(point Vector2f, view *View) *Vector2i {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := point.ToC()
 	var2 := view.ToC()
@@ -1887,6 +2008,7 @@ func (r *RenderWindow) MapCoordsToPixel(point Vector2f, view *View) *Vector2i {
 }
 
 func (r *RenderWindow) MapPixelToCoords(point Vector2i, view *View) *Vector2f {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := point.ToC()
 	var2 := view.ToC()
@@ -1896,6 +2018,7 @@ func (r *RenderWindow) MapPixelToCoords(point Vector2i, view *View) *Vector2f {
 }
 
 func (r *RenderWindow) PollEvent() (Event, bool) {
+	assertMainThread()
 	var0 := r.ToC()
 	returnParam := C.sfEvent{}
 	funcRes0 := C.sfRenderWindow_pollEvent(var0, &returnParam)
@@ -1904,27 +2027,32 @@ func (r *RenderWindow) PollEvent() (Event, bool) {
 	return returnParamRes, res
 }
 
-func (r *RenderWindow) PopGlsTates() {
+func (r *RenderWindow) PopGLStates() {
+	assertMainThread()
 	var0 := r.ToC()
 	C.sfRenderWindow_popGLStates(var0)
 }
 
-func (r *RenderWindow) PushGlsTates() {
+func (r *RenderWindow) PushGLStates() {
+	assertMainThread()
 	var0 := r.ToC()
 	C.sfRenderWindow_pushGLStates(var0)
 }
 
 func (r *RenderWindow) RequestFocus() {
+	assertMainThread()
 	var0 := r.ToC()
 	C.sfRenderWindow_requestFocus(var0)
 }
 
-func (r *RenderWindow) ResetGlsTates() {
+func (r *RenderWindow) ResetGLStates() {
+	assertMainThread()
 	var0 := r.ToC()
 	C.sfRenderWindow_resetGLStates(var0)
 }
 
 func (r *RenderWindow) SetActive(active bool) bool {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := boolToSfBool(active)
 	funcRes0 := C.sfRenderWindow_setActive(var0, var1)
@@ -1933,12 +2061,14 @@ func (r *RenderWindow) SetActive(active bool) bool {
 }
 
 func (r *RenderWindow) SetFramerateLimit(limit int32) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := C.uint(limit)
 	C.sfRenderWindow_setFramerateLimit(var0, var1)
 }
 
 func (r *RenderWindow) SetIcon(width int32, height int32, pixels *uint8) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := C.uint(width)
 	var2 := C.uint(height)
@@ -1947,78 +2077,92 @@ func (r *RenderWindow) SetIcon(width int32, height int32, pixels *uint8) {
 }
 
 func (r *RenderWindow) SetJoystickThreshold(threshold float32) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := C.float(threshold)
 	C.sfRenderWindow_setJoystickThreshold(var0, var1)
 }
 
 func (r *RenderWindow) SetKeyRepeatEnabled(enabled bool) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := boolToSfBool(enabled)
 	C.sfRenderWindow_setKeyRepeatEnabled(var0, var1)
 }
 
 func (r *RenderWindow) SetMouseCursor(cursor *Cursor) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := cursor.ToC()
 	C.sfRenderWindow_setMouseCursor(var0, var1)
 }
 
 func (r *RenderWindow) SetMouseCursorGrabbed(grabbed bool) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := boolToSfBool(grabbed)
 	C.sfRenderWindow_setMouseCursorGrabbed(var0, var1)
 }
 
 func (r *RenderWindow) SetMouseCursorVisible(show bool) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := boolToSfBool(show)
 	C.sfRenderWindow_setMouseCursorVisible(var0, var1)
 }
 
 func (r *RenderWindow) SetPosition(position Vector2i) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := position.ToC()
 	C.sfRenderWindow_setPosition(var0, var1)
 }
 
 func (r *RenderWindow) SetSize(size Vector2u) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := size.ToC()
 	C.sfRenderWindow_setSize(var0, var1)
 }
 
 func (r *RenderWindow) SetTitle(title string) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := C.CString(title)
 	C.sfRenderWindow_setTitle(var0, var1)
 }
 
-func (r *RenderWindow) SetUnicodeTitle(title *uint32) {
+func (r *RenderWindow) SetUnicodeTitle(title string) {
+	assertMainThread()
 	var0 := r.ToC()
-	var1 := (*C.sfUint32)(title)
+	var1 := (*C.sfUint32)(stringToUTF32(title))
+	defer C.free(unsafe.Pointer(var1))
 	C.sfRenderWindow_setUnicodeTitle(var0, var1)
 }
 
 func (r *RenderWindow) SetVerticalSyncEnabled(enabled bool) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := boolToSfBool(enabled)
 	C.sfRenderWindow_setVerticalSyncEnabled(var0, var1)
 }
 
 func (r *RenderWindow) SetView(view *View) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := view.ToC()
 	C.sfRenderWindow_setView(var0, var1)
 }
 
 func (r *RenderWindow) SetVisible(visible bool) {
+	assertMainThread()
 	var0 := r.ToC()
 	var1 := boolToSfBool(visible)
 	C.sfRenderWindow_setVisible(var0, var1)
 }
 
 func (r *RenderWindow) WaitEvent() (Event, bool) {
+	assertMainThread()
 	var0 := r.ToC()
 	returnParam := C.sfEvent{}
 	funcRes0 := C.sfRenderWindow_waitEvent(var0, &returnParam)
@@ -2244,30 +2388,32 @@ func (s *Shader) SetMat3uniform(name string, matrix *GlslMat3) {
 	var0 := s.ToC()
 	var1 := C.CString(name)
 	var2 := matrix.ToC()
-	C.sfShader_setMat3Uniform(var0, var1, var2)
+	C.sfShader_setMat3Uniform(var0, var1, &var2)
 }
 
-func (s *Shader) SetMat3uniformArray(name string, matrixArray *GlslMat3, length uint64) {
+func (s *Shader) SetMat3uniformArray(name string, matrixArray []GlslMat3) {
 	var0 := s.ToC()
 	var1 := C.CString(name)
-	var2 := matrixArray.ToC()
-	var3 := C.size_t(length)
-	C.sfShader_setMat3UniformArray(var0, var1, var2, var3)
+	var2Count := C.size_t(len(matrixArray))
+	var2Array := NewGlslMat3CArrayFromGoSlice(matrixArray)
+	defer C.free(unsafe.Pointer(var2Array))
+	C.sfShader_setMat3UniformArray(var0, var1, var2Array, var2Count)
 }
 
 func (s *Shader) SetMat4uniform(name string, matrix *GlslMat4) {
 	var0 := s.ToC()
 	var1 := C.CString(name)
 	var2 := matrix.ToC()
-	C.sfShader_setMat4Uniform(var0, var1, var2)
+	C.sfShader_setMat4Uniform(var0, var1, &var2)
 }
 
-func (s *Shader) SetMat4uniformArray(name string, matrixArray *GlslMat4, length uint64) {
+func (s *Shader) SetMat4uniformArray(name string, matrixArray []GlslMat4) {
 	var0 := s.ToC()
 	var1 := C.CString(name)
-	var2 := matrixArray.ToC()
-	var3 := C.size_t(length)
-	C.sfShader_setMat4UniformArray(var0, var1, var2, var3)
+	var2Count := C.size_t(len(matrixArray))
+	var2Array := NewGlslMat4CArrayFromGoSlice(matrixArray)
+	defer C.free(unsafe.Pointer(var2Array))
+	C.sfShader_setMat4UniformArray(var0, var1, var2Array, var2Count)
 }
 
 func (s *Shader) SetTextureParameter(name string, texture *Texture) {
@@ -2298,12 +2444,13 @@ func (s *Shader) SetVec2uniform(name string, vector Vector2f) {
 	C.sfShader_setVec2Uniform(var0, var1, var2)
 }
 
-func (s *Shader) SetVec2uniformArray(name string, vectorArray *Vector2f, length uint64) {
+func (s *Shader) SetVec2uniformArray(name string, vectorArray []Vector2f) {
 	var0 := s.ToC()
 	var1 := C.CString(name)
-	var2 := vectorArray.ToC()
-	var3 := C.size_t(length)
-	C.sfShader_setVec2UniformArray(var0, var1, &var2, var3)
+	var2Count := C.size_t(len(vectorArray))
+	var2Array := NewVector2fCArrayFromGoSlice(vectorArray)
+	defer C.free(unsafe.Pointer(var2Array))
+	C.sfShader_setVec2UniformArray(var0, var1, var2Array, var2Count)
 }
 
 func (s *Shader) SetVec3uniform(name string, vector Vector3f) {
@@ -2313,12 +2460,13 @@ func (s *Shader) SetVec3uniform(name string, vector Vector3f) {
 	C.sfShader_setVec3Uniform(var0, var1, var2)
 }
 
-func (s *Shader) SetVec3uniformArray(name string, vectorArray *Vector3f, length uint64) {
+func (s *Shader) SetVec3uniformArray(name string, vectorArray []Vector3f) {
 	var0 := s.ToC()
 	var1 := C.CString(name)
-	var2 := vectorArray.ToC()
-	var3 := C.size_t(length)
-	C.sfShader_setVec3UniformArray(var0, var1, &var2, var3)
+	var2Count := C.size_t(len(vectorArray))
+	var2Array := NewVector3fCArrayFromGoSlice(vectorArray)
+	defer C.free(unsafe.Pointer(var2Array))
+	C.sfShader_setVec3UniformArray(var0, var1, var2Array, var2Count)
 }
 
 func (s *Shader) SetVec4uniform(name string, vector Vector4f) {
@@ -2328,12 +2476,13 @@ func (s *Shader) SetVec4uniform(name string, vector Vector4f) {
 	C.sfShader_setVec4Uniform(var0, var1, var2)
 }
 
-func (s *Shader) SetVec4uniformArray(name string, vectorArray *Vector4f, length uint64) {
+func (s *Shader) SetVec4uniformArray(name string, vectorArray []Vector4f) {
 	var0 := s.ToC()
 	var1 := C.CString(name)
-	var2 := vectorArray.ToC()
-	var3 := C.size_t(length)
-	C.sfShader_setVec4UniformArray(var0, var1, &var2, var3)
+	var2Count := C.size_t(len(vectorArray))
+	var2Array := NewVector4fCArrayFromGoSlice(vectorArray)
+	defer C.free(unsafe.Pointer(var2Array))
+	C.sfShader_setVec4UniformArray(var0, var1, var2Array, var2Count)
 }
 
 func (s *Shader) SetVector2parameter(name string, vector Vector2f) {
@@ -2539,6 +2688,11 @@ func (s *Shape) Update() {
 	C.sfShape_update(var0)
 }
 
+func Sleep(duration time.Duration) {
+	var0 := durationToSfTime(duration)
+	C.sfSleep(var0)
+}
+
 func (s *Sprite) Copy() *Sprite {
 	var0 := s.ToC()
 	funcRes0 := C.sfSprite_copy(var0)
@@ -2831,10 +2985,10 @@ func (t *Text) String() string {
 	return res
 }
 
-func (t *Text) Style() uint32 {
+func (t *Text) Style() TextStyle {
 	var0 := t.ToC()
 	funcRes0 := C.sfText_getStyle(var0)
-	res := uint32(funcRes0)
+	res := TextStyle(funcRes0)
 	return res
 }
 
@@ -2845,10 +2999,10 @@ func (t *Text) Transform() *Transform {
 	return res
 }
 
-func (t *Text) UnicodeString() *uint32 {
+func (t *Text) UnicodeString() string {
 	var0 := t.ToC()
 	funcRes0 := C.sfText_getUnicodeString(var0)
-	res := (*uint32)(funcRes0)
+	res := utf32ToString(unsafe.Pointer(funcRes0))
 	return res
 }
 
@@ -2948,15 +3102,16 @@ func (t *Text) SetString(string string) {
 	C.sfText_setString(var0, var1)
 }
 
-func (t *Text) SetStyle(style uint32) {
+func (t *Text) SetStyle(style TextStyle) {
 	var0 := t.ToC()
 	var1 := C.sfUint32(style)
 	C.sfText_setStyle(var0, var1)
 }
 
-func (t *Text) SetUnicodeString(string *uint32) {
+func (t *Text) SetUnicodeString(string string) {
 	var0 := t.ToC()
-	var1 := (*C.sfUint32)(string)
+	var1 := (*C.sfUint32)(stringToUTF32(string))
+	defer C.free(unsafe.Pointer(var1))
 	C.sfText_setUnicodeString(var0, var1)
 }
 
@@ -3181,28 +3336,41 @@ func (t *Time) AsSeconds() float32 {
 	return res
 }
 
-func TouchGetPosition(finger int32, relativeTo *Window) *Vector2i {
+func touchGetPositionWindow(finger int32, relativeTo *Window) *Vector2i {
+	assertMainThread()
 	var0 := C.uint(finger)
-	var1 := relativeTo.ToC()
+	var var1 *C.sfWindow = nil
+	if relativeTo != nil {
+	  var1 = relativeTo.ToC()
+	}
 	funcRes0 := C.sfTouch_getPosition(var0, var1)
 	return NewVector2iFromC(funcRes0)
 }
 
-func TouchGetPositionRenderWindow(finger int32, relativeTo *RenderWindow) *Vector2i {
+func touchGetPositionRenderWindow(finger int32, relativeTo *RenderWindow) *Vector2i {
+	assertMainThread()
 	var0 := C.uint(finger)
-	var1 := relativeTo.ToC()
+	var var1 *C.sfRenderWindow = nil
+	if relativeTo != nil {
+	  var1 = relativeTo.ToC()
+	}
 	funcRes0 := C.sfTouch_getPositionRenderWindow(var0, var1)
 	return NewVector2iFromC(funcRes0)
 }
 
-func TouchGetPositionWindowBase(finger int32, relativeTo *WindowBase) *Vector2i {
+func touchGetPositionWindowBase(finger int32, relativeTo *WindowBase) *Vector2i {
+	assertMainThread()
 	var0 := C.uint(finger)
-	var1 := relativeTo.ToC()
+	var var1 *C.sfWindowBase = nil
+	if relativeTo != nil {
+	  var1 = relativeTo.ToC()
+	}
 	funcRes0 := C.sfTouch_getPositionWindowBase(var0, var1)
 	return NewVector2iFromC(funcRes0)
 }
 
 func TouchIsDown(finger int32) bool {
+	assertMainThread()
 	var0 := C.uint(finger)
 	return sfBoolToBool(C.sfTouch_isDown(var0))
 }
@@ -3542,8 +3710,9 @@ func (v *VertexBuffer) Update(vertices []Vertex, offset int32) bool {
 	var0 := v.ToC()
 	var1Count := C.uint(len(vertices))
 	var1Array := NewVertexCArrayFromGoSlice(vertices)
-	var3 := C.uint(offset)
-	funcRes0 := C.sfVertexBuffer_update(var0, var1Array, var1Count, var3)
+	defer C.free(unsafe.Pointer(var1Array))
+	var4 := C.uint(offset)
+	funcRes0 := C.sfVertexBuffer_update(var0, var1Array, var1Count, var4)
 	res := sfBoolToBool(funcRes0)
 	return res
 }
@@ -3668,11 +3837,13 @@ func (v *View) Zoom(factor float32) {
 }
 
 func (w *WindowBase) Close() {
+	assertMainThread()
 	var0 := w.ToC()
 	C.sfWindowBase_close(var0)
 }
 
-func NewWindowBase(mode VideoMode, title string, style uint32) *WindowBase {
+func NewWindowBase(mode VideoMode, title string, style WindowStyle) *WindowBase {
+	assertMainThread()
 	var0 := mode.ToC()
 	var1 := C.CString(title)
 	var2 := C.sfUint32(style)
@@ -3681,25 +3852,30 @@ func NewWindowBase(mode VideoMode, title string, style uint32) *WindowBase {
 }
 
 func NewWindowBaseFromHandle(handle uintptr) *WindowBase {
+	assertMainThread()
 	var0 := C.sfWindowHandle(handle)
 	funcRes0 := C.sfWindowBase_createFromHandle(var0)
 	return NewWindowBaseFromC(funcRes0)
 }
 
-func NewWindowBaseUnicode(mode VideoMode, title *uint32, style uint32) *WindowBase {
+func NewWindowBaseUnicode(mode VideoMode, title string, style WindowStyle) *WindowBase {
+	assertMainThread()
 	var0 := mode.ToC()
-	var1 := (*C.sfUint32)(title)
-	var2 := C.sfUint32(style)
-	funcRes0 := C.sfWindowBase_createUnicode(var0, var1, var2)
+	var1 := (*C.sfUint32)(stringToUTF32(title))
+	defer C.free(unsafe.Pointer(var1))
+	var3 := C.sfUint32(style)
+	funcRes0 := C.sfWindowBase_createUnicode(var0, var1, var3)
 	return NewWindowBaseFromC(funcRes0)
 }
 
 func (w *WindowBase) Free() {
+	assertMainThread()
 	var0 := w.ToC()
 	C.sfWindowBase_destroy(var0)
 }
 
 func (w *WindowBase) Position() *Vector2i {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindowBase_getPosition(var0)
 	res := NewVector2iFromC(funcRes0)
@@ -3707,6 +3883,7 @@ func (w *WindowBase) Position() *Vector2i {
 }
 
 func (w *WindowBase) Size() *Vector2u {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindowBase_getSize(var0)
 	res := NewVector2uFromC(funcRes0)
@@ -3714,6 +3891,7 @@ func (w *WindowBase) Size() *Vector2u {
 }
 
 func (w *WindowBase) SystemHandle() uintptr {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindowBase_getSystemHandle(var0)
 	res := uintptr(funcRes0)
@@ -3721,6 +3899,7 @@ func (w *WindowBase) SystemHandle() uintptr {
 }
 
 func (w *WindowBase) HasFocus() bool {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindowBase_hasFocus(var0)
 	res := sfBoolToBool(funcRes0)
@@ -3728,6 +3907,7 @@ func (w *WindowBase) HasFocus() bool {
 }
 
 func (w *WindowBase) IsOpen() bool {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindowBase_isOpen(var0)
 	res := sfBoolToBool(funcRes0)
@@ -3735,6 +3915,7 @@ func (w *WindowBase) IsOpen() bool {
 }
 
 func (w *WindowBase) PollEvent() (Event, bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	returnParam := C.sfEvent{}
 	funcRes0 := C.sfWindowBase_pollEvent(var0, &returnParam)
@@ -3744,11 +3925,13 @@ func (w *WindowBase) PollEvent() (Event, bool) {
 }
 
 func (w *WindowBase) RequestFocus() {
+	assertMainThread()
 	var0 := w.ToC()
 	C.sfWindowBase_requestFocus(var0)
 }
 
 func (w *WindowBase) SetIcon(width int32, height int32, pixels *uint8) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := C.uint(width)
 	var2 := C.uint(height)
@@ -3757,66 +3940,78 @@ func (w *WindowBase) SetIcon(width int32, height int32, pixels *uint8) {
 }
 
 func (w *WindowBase) SetJoystickThreshold(threshold float32) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := C.float(threshold)
 	C.sfWindowBase_setJoystickThreshold(var0, var1)
 }
 
 func (w *WindowBase) SetKeyRepeatEnabled(enabled bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(enabled)
 	C.sfWindowBase_setKeyRepeatEnabled(var0, var1)
 }
 
 func (w *WindowBase) SetMouseCursor(cursor *Cursor) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := cursor.ToC()
 	C.sfWindowBase_setMouseCursor(var0, var1)
 }
 
 func (w *WindowBase) SetMouseCursorGrabbed(grabbed bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(grabbed)
 	C.sfWindowBase_setMouseCursorGrabbed(var0, var1)
 }
 
 func (w *WindowBase) SetMouseCursorVisible(visible bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(visible)
 	C.sfWindowBase_setMouseCursorVisible(var0, var1)
 }
 
 func (w *WindowBase) SetPosition(position Vector2i) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := position.ToC()
 	C.sfWindowBase_setPosition(var0, var1)
 }
 
 func (w *WindowBase) SetSize(size Vector2u) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := size.ToC()
 	C.sfWindowBase_setSize(var0, var1)
 }
 
 func (w *WindowBase) SetTitle(title string) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := C.CString(title)
 	C.sfWindowBase_setTitle(var0, var1)
 }
 
-func (w *WindowBase) SetUnicodeTitle(title *uint32) {
+func (w *WindowBase) SetUnicodeTitle(title string) {
+	assertMainThread()
 	var0 := w.ToC()
-	var1 := (*C.sfUint32)(title)
+	var1 := (*C.sfUint32)(stringToUTF32(title))
+	defer C.free(unsafe.Pointer(var1))
 	C.sfWindowBase_setUnicodeTitle(var0, var1)
 }
 
 func (w *WindowBase) SetVisible(visible bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(visible)
 	C.sfWindowBase_setVisible(var0, var1)
 }
 
 func (w *WindowBase) WaitEvent() (Event, bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	returnParam := C.sfEvent{}
 	funcRes0 := C.sfWindowBase_waitEvent(var0, &returnParam)
@@ -3826,11 +4021,13 @@ func (w *WindowBase) WaitEvent() (Event, bool) {
 }
 
 func (w *Window) Close() {
+	assertMainThread()
 	var0 := w.ToC()
 	C.sfWindow_close(var0)
 }
 
-func NewWindow(mode VideoMode, title string, style uint32, settings *ContextSettings) *Window {
+func NewWindow(mode VideoMode, title string, style WindowStyle, settings *ContextSettings) *Window {
+	assertMainThread()
 	var0 := mode.ToC()
 	var1 := C.CString(title)
 	var2 := C.sfUint32(style)
@@ -3840,32 +4037,38 @@ func NewWindow(mode VideoMode, title string, style uint32, settings *ContextSett
 }
 
 func NewWindowFromHandle(handle uintptr, settings *ContextSettings) *Window {
+	assertMainThread()
 	var0 := C.sfWindowHandle(handle)
 	var1 := settings.ToC()
 	funcRes0 := C.sfWindow_createFromHandle(var0, &var1)
 	return NewWindowFromC(funcRes0)
 }
 
-func NewWindowUnicode(mode VideoMode, title *uint32, style uint32, settings *ContextSettings) *Window {
+func NewWindowUnicode(mode VideoMode, title string, style WindowStyle, settings *ContextSettings) *Window {
+	assertMainThread()
 	var0 := mode.ToC()
-	var1 := (*C.sfUint32)(title)
-	var2 := C.sfUint32(style)
-	var3 := settings.ToC()
-	funcRes0 := C.sfWindow_createUnicode(var0, var1, var2, &var3)
+	var1 := (*C.sfUint32)(stringToUTF32(title))
+	defer C.free(unsafe.Pointer(var1))
+	var3 := C.sfUint32(style)
+	var4 := settings.ToC()
+	funcRes0 := C.sfWindow_createUnicode(var0, var1, var3, &var4)
 	return NewWindowFromC(funcRes0)
 }
 
 func (w *Window) Free() {
+	assertMainThread()
 	var0 := w.ToC()
 	C.sfWindow_destroy(var0)
 }
 
 func (w *Window) Display() {
+	assertMainThread()
 	var0 := w.ToC()
 	C.sfWindow_display(var0)
 }
 
 func (w *Window) Position() *Vector2i {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindow_getPosition(var0)
 	res := NewVector2iFromC(funcRes0)
@@ -3873,6 +4076,7 @@ func (w *Window) Position() *Vector2i {
 }
 
 func (w *Window) Settings() *ContextSettings {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindow_getSettings(var0)
 	res := NewContextSettingsFromC(funcRes0)
@@ -3880,6 +4084,7 @@ func (w *Window) Settings() *ContextSettings {
 }
 
 func (w *Window) Size() *Vector2u {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindow_getSize(var0)
 	res := NewVector2uFromC(funcRes0)
@@ -3887,6 +4092,7 @@ func (w *Window) Size() *Vector2u {
 }
 
 func (w *Window) SystemHandle() uintptr {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindow_getSystemHandle(var0)
 	res := uintptr(funcRes0)
@@ -3894,6 +4100,7 @@ func (w *Window) SystemHandle() uintptr {
 }
 
 func (w *Window) HasFocus() bool {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindow_hasFocus(var0)
 	res := sfBoolToBool(funcRes0)
@@ -3901,6 +4108,7 @@ func (w *Window) HasFocus() bool {
 }
 
 func (w *Window) IsOpen() bool {
+	assertMainThread()
 	var0 := w.ToC()
 	funcRes0 := C.sfWindow_isOpen(var0)
 	res := sfBoolToBool(funcRes0)
@@ -3908,6 +4116,7 @@ func (w *Window) IsOpen() bool {
 }
 
 func (w *Window) PollEvent() (Event, bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	returnParam := C.sfEvent{}
 	funcRes0 := C.sfWindow_pollEvent(var0, &returnParam)
@@ -3917,11 +4126,13 @@ func (w *Window) PollEvent() (Event, bool) {
 }
 
 func (w *Window) RequestFocus() {
+	assertMainThread()
 	var0 := w.ToC()
 	C.sfWindow_requestFocus(var0)
 }
 
 func (w *Window) SetActive(active bool) bool {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(active)
 	funcRes0 := C.sfWindow_setActive(var0, var1)
@@ -3930,12 +4141,14 @@ func (w *Window) SetActive(active bool) bool {
 }
 
 func (w *Window) SetFramerateLimit(limit int32) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := C.uint(limit)
 	C.sfWindow_setFramerateLimit(var0, var1)
 }
 
 func (w *Window) SetIcon(width int32, height int32, pixels *uint8) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := C.uint(width)
 	var2 := C.uint(height)
@@ -3944,72 +4157,85 @@ func (w *Window) SetIcon(width int32, height int32, pixels *uint8) {
 }
 
 func (w *Window) SetJoystickThreshold(threshold float32) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := C.float(threshold)
 	C.sfWindow_setJoystickThreshold(var0, var1)
 }
 
 func (w *Window) SetKeyRepeatEnabled(enabled bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(enabled)
 	C.sfWindow_setKeyRepeatEnabled(var0, var1)
 }
 
 func (w *Window) SetMouseCursor(cursor *Cursor) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := cursor.ToC()
 	C.sfWindow_setMouseCursor(var0, var1)
 }
 
 func (w *Window) SetMouseCursorGrabbed(grabbed bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(grabbed)
 	C.sfWindow_setMouseCursorGrabbed(var0, var1)
 }
 
 func (w *Window) SetMouseCursorVisible(visible bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(visible)
 	C.sfWindow_setMouseCursorVisible(var0, var1)
 }
 
 func (w *Window) SetPosition(position Vector2i) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := position.ToC()
 	C.sfWindow_setPosition(var0, var1)
 }
 
 func (w *Window) SetSize(size Vector2u) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := size.ToC()
 	C.sfWindow_setSize(var0, var1)
 }
 
 func (w *Window) SetTitle(title string) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := C.CString(title)
 	C.sfWindow_setTitle(var0, var1)
 }
 
-func (w *Window) SetUnicodeTitle(title *uint32) {
+func (w *Window) SetUnicodeTitle(title string) {
+	assertMainThread()
 	var0 := w.ToC()
-	var1 := (*C.sfUint32)(title)
+	var1 := (*C.sfUint32)(stringToUTF32(title))
+	defer C.free(unsafe.Pointer(var1))
 	C.sfWindow_setUnicodeTitle(var0, var1)
 }
 
 func (w *Window) SetVerticalSyncEnabled(enabled bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(enabled)
 	C.sfWindow_setVerticalSyncEnabled(var0, var1)
 }
 
 func (w *Window) SetVisible(visible bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	var1 := boolToSfBool(visible)
 	C.sfWindow_setVisible(var0, var1)
 }
 
 func (w *Window) WaitEvent() (Event, bool) {
+	assertMainThread()
 	var0 := w.ToC()
 	returnParam := C.sfEvent{}
 	funcRes0 := C.sfWindow_waitEvent(var0, &returnParam)
@@ -4018,3 +4244,210 @@ func (w *Window) WaitEvent() (Event, bool) {
 	return returnParamRes, res
 }
 
+func MouseGetPosition(relativeTo WindowLike) *Vector2i {
+	switch relativeTo := relativeTo.(type) {
+	case nil:
+		return mouseGetPositionWindow(nil)
+	case *Window:
+		return mouseGetPositionWindow(relativeTo)
+	case *RenderWindow:
+		return mouseGetPositionRenderWindow(relativeTo)
+	case *WindowBase:
+		return mouseGetPositionWindowBase(relativeTo)
+	default:
+		panic("sfml: MouseGetPosition does not support this WindowLike implementation")
+	}
+}
+
+func MouseSetPosition(position Vector2i, relativeTo WindowLike) {
+	switch relativeTo := relativeTo.(type) {
+	case nil:
+		mouseSetPositionWindow(position, nil)
+	case *Window:
+		mouseSetPositionWindow(position, relativeTo)
+	case *RenderWindow:
+		mouseSetPositionRenderWindow(position, relativeTo)
+	case *WindowBase:
+		mouseSetPositionWindowBase(position, relativeTo)
+	default:
+		panic("sfml: MouseSetPosition does not support this WindowLike implementation")
+	}
+}
+
+func TouchGetPosition(finger int32, relativeTo WindowLike) *Vector2i {
+	switch relativeTo := relativeTo.(type) {
+	case nil:
+		return touchGetPositionWindow(finger, nil)
+	case *Window:
+		return touchGetPositionWindow(finger, relativeTo)
+	case *RenderWindow:
+		return touchGetPositionRenderWindow(finger, relativeTo)
+	case *WindowBase:
+		return touchGetPositionWindowBase(finger, relativeTo)
+	default:
+		panic("sfml: TouchGetPosition does not support this WindowLike implementation")
+	}
+}
+
+type RenderTarget interface {
+	Clear(color Color)
+	DrawCircleShape(object *CircleShape, states *RenderStates)
+	DrawConvexShape(object *ConvexShape, states *RenderStates)
+	DrawPrimitives(vertices *Vertex, vertexCount uint64, primitiveType PrimitiveType, states *RenderStates)
+	DrawRectangleShape(object *RectangleShape, states *RenderStates)
+	DrawShape(object *Shape, states *RenderStates)
+	DrawSprite(object *Sprite, states *RenderStates)
+	DrawText(object *Text, states *RenderStates)
+	DrawVertexArray(object *VertexArray, states *RenderStates)
+	DrawVertexBuffer(object *VertexBuffer, states *RenderStates)
+	DrawVertexBufferRange(object *VertexBuffer, firstVertex uint64, vertexCount uint64, states *RenderStates)
+	DefaultView() *View
+	Size() *Vector2u
+	View() *View
+	Viewport(view *View) *IntRect
+	MapCoordsToPixel(point Vector2f, view *View) *Vector2i
+	MapPixelToCoords(point Vector2i, view *View) *Vector2f
+	SetView(view *View)
+	Draw(drawable Drawable, states *RenderStates)
+}
+
+var _ RenderTarget = (*RenderWindow)(nil)
+
+var _ RenderTarget = (*RenderTexture)(nil)
+
+type Drawable interface {
+	DrawTo(target RenderTarget, states *RenderStates)
+}
+
+func (c *CircleShape) DrawTo(target RenderTarget, states *RenderStates) {
+	target.DrawCircleShape(c, states)
+}
+
+var _ Drawable = (*CircleShape)(nil)
+
+func (c *ConvexShape) DrawTo(target RenderTarget, states *RenderStates) {
+	target.DrawConvexShape(c, states)
+}
+
+var _ Drawable = (*ConvexShape)(nil)
+
+func (r *RectangleShape) DrawTo(target RenderTarget, states *RenderStates) {
+	target.DrawRectangleShape(r, states)
+}
+
+var _ Drawable = (*RectangleShape)(nil)
+
+func (s *Shape) DrawTo(target RenderTarget, states *RenderStates) {
+	target.DrawShape(s, states)
+}
+
+var _ Drawable = (*Shape)(nil)
+
+func (s *Sprite) DrawTo(target RenderTarget, states *RenderStates) {
+	target.DrawSprite(s, states)
+}
+
+var _ Drawable = (*Sprite)(nil)
+
+func (t *Text) DrawTo(target RenderTarget, states *RenderStates) {
+	target.DrawText(t, states)
+}
+
+var _ Drawable = (*Text)(nil)
+
+func (v *VertexArray) DrawTo(target RenderTarget, states *RenderStates) {
+	target.DrawVertexArray(v, states)
+}
+
+var _ Drawable = (*VertexArray)(nil)
+
+func (v *VertexBuffer) DrawTo(target RenderTarget, states *RenderStates) {
+	target.DrawVertexBuffer(v, states)
+}
+
+var _ Drawable = (*VertexBuffer)(nil)
+
+func (r *RenderWindow) Draw(drawable Drawable, states *RenderStates) {
+	drawable.DrawTo(r, states)
+}
+
+func (r *RenderTexture) Draw(drawable Drawable, states *RenderStates) {
+	drawable.DrawTo(r, states)
+}
+
+type WindowLike interface {
+	SystemHandle() uintptr
+}
+
+var _ WindowLike = (*Window)(nil)
+
+var _ WindowLike = (*RenderWindow)(nil)
+
+var _ WindowLike = (*WindowBase)(nil)
+
+type Transformer interface {
+	InverseTransform() *Transform
+	Origin() *Vector2f
+	Position() *Vector2f
+	Rotation() float32
+	GetScale() *Vector2f
+	Transform() *Transform
+	Move(offset Vector2f)
+	Rotate(angle float32)
+	Scale(factors Vector2f)
+	SetOrigin(origin Vector2f)
+	SetPosition(position Vector2f)
+	SetRotation(angle float32)
+	SetScale(scale Vector2f)
+}
+
+var _ Transformer = (*Transformable)(nil)
+
+var _ Transformer = (*Sprite)(nil)
+
+var _ Transformer = (*Text)(nil)
+
+var _ Transformer = (*CircleShape)(nil)
+
+var _ Transformer = (*RectangleShape)(nil)
+
+var _ Transformer = (*ConvexShape)(nil)
+
+var _ Transformer = (*Shape)(nil)
+
+type Bounded interface {
+	GlobalBounds() *FloatRect
+	LocalBounds() *FloatRect
+}
+
+var _ Bounded = (*Sprite)(nil)
+
+var _ Bounded = (*Text)(nil)
+
+var _ Bounded = (*CircleShape)(nil)
+
+var _ Bounded = (*RectangleShape)(nil)
+
+var _ Bounded = (*ConvexShape)(nil)
+
+var _ Bounded = (*Shape)(nil)
+
+type Colorable interface {
+	FillColor() *Color
+	OutlineColor() *Color
+	OutlineThickness() float32
+	SetFillColor(color Color)
+	SetOutlineColor(color Color)
+	SetOutlineThickness(thickness float32)
+}
+
+var _ Colorable = (*Text)(nil)
+
+var _ Colorable = (*CircleShape)(nil)
+
+var _ Colorable = (*RectangleShape)(nil)
+
+var _ Colorable = (*ConvexShape)(nil)
+
+var _ Colorable = (*Shape)(nil)
+
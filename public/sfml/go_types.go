@@ -72,12 +72,12 @@ package sfml
 // #include <SFML/Window/WindowHandle.h>
 // #cgo LDFLAGS: -lcsfml-graphics -lcsfml-window -lcsfml-system -lsfml-graphics -lsfml-window -lsfml-system -lX11 -lstdc++ -lm -lGL -ludev -lXrandr -lfreetype -lXcursor
 //
-// static inline sfEventType get_sfMouseWheelScrollEvent_type(const sfMouseWheelScrollEvent* a) {
+// static inline sfEventType get_sfKeyEvent_type(const sfKeyEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfMouseWheelScrollEvent_type(sfMouseWheelScrollEvent* a, sfEventType type) {
+// static inline void set_sfKeyEvent_type(sfKeyEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
@@ -92,72 +92,72 @@ package sfml
 // }
 //
 //
-// static inline sfEventType get_sfMouseMoveEvent_type(const sfMouseMoveEvent* a) {
+// static inline sfEventType get_sfMouseWheelEvent_type(const sfMouseWheelEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfMouseMoveEvent_type(sfMouseMoveEvent* a, sfEventType type) {
+// static inline void set_sfMouseWheelEvent_type(sfMouseWheelEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfKeyEvent_type(const sfKeyEvent* a) {
+// static inline sfEventType get_sfSizeEvent_type(const sfSizeEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfKeyEvent_type(sfKeyEvent* a, sfEventType type) {
+// static inline void set_sfSizeEvent_type(sfSizeEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfMouseButtonEvent_type(const sfMouseButtonEvent* a) {
+// static inline sfEventType get_sfMouseMoveEvent_type(const sfMouseMoveEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfMouseButtonEvent_type(sfMouseButtonEvent* a, sfEventType type) {
+// static inline void set_sfMouseMoveEvent_type(sfMouseMoveEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfMouseWheelEvent_type(const sfMouseWheelEvent* a) {
+// static inline sfEventType get_sfTouchEvent_type(const sfTouchEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfMouseWheelEvent_type(sfMouseWheelEvent* a, sfEventType type) {
+// static inline void set_sfTouchEvent_type(sfTouchEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfTouchEvent_type(const sfTouchEvent* a) {
+// static inline sfEventType get_sfMouseButtonEvent_type(const sfMouseButtonEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfTouchEvent_type(sfTouchEvent* a, sfEventType type) {
+// static inline void set_sfMouseButtonEvent_type(sfMouseButtonEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfSensorEvent_type(const sfSensorEvent* a) {
+// static inline sfEventType get_sfMouseWheelScrollEvent_type(const sfMouseWheelScrollEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfSensorEvent_type(sfSensorEvent* a, sfEventType type) {
+// static inline void set_sfMouseWheelScrollEvent_type(sfMouseWheelScrollEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
 //
-// static inline sfEventType get_sfSizeEvent_type(const sfSizeEvent* a) {
+// static inline sfEventType get_sfSensorEvent_type(const sfSensorEvent* a) {
 //     return a->type;
 // }
 //
 //
-// static inline void set_sfSizeEvent_type(sfSizeEvent* a, sfEventType type) {
+// static inline void set_sfSensorEvent_type(sfSensorEvent* a, sfEventType type) {
 //     a->type = type;
 // }
 //
@@ -176,49 +176,98 @@ package sfml
 //     return a->size;
 // }
 //
+//
+// static inline void set_sfSizeEvent_in_sfEvent_union(sfEvent* a, sfSizeEvent value) {
+//     a->size = value;
+// }
+//
 // 
 // static inline sfKeyEvent get_sfKeyEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->key;
 // }
 //
+//
+// static inline void set_sfKeyEvent_in_sfEvent_union(sfEvent* a, sfKeyEvent value) {
+//     a->key = value;
+// }
+//
 // 
 // static inline sfTextEvent get_sfTextEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->text;
 // }
 //
+//
+// static inline void set_sfTextEvent_in_sfEvent_union(sfEvent* a, sfTextEvent value) {
+//     a->text = value;
+// }
+//
 // 
 // static inline sfMouseMoveEvent get_sfMouseMoveEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->mouseMove;
 // }
 //
+//
+// static inline void set_sfMouseMoveEvent_in_sfEvent_union(sfEvent* a, sfMouseMoveEvent value) {
+//     a->mouseMove = value;
+// }
+//
 // 
 // static inline sfMouseButtonEvent get_sfMouseButtonEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->mouseButton;
 // }
 //
+//
+// static inline void set_sfMouseButtonEvent_in_sfEvent_union(sfEvent* a, sfMouseButtonEvent value) {
+//     a->mouseButton = value;
+// }
+//
 // 
 // static inline sfMouseWheelEvent get_sfMouseWheelEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->mouseWheel;
 // }
 //
+//
+// static inline void set_sfMouseWheelEvent_in_sfEvent_union(sfEvent* a, sfMouseWheelEvent value) {
+//     a->mouseWheel = value;
+// }
+//
 // 
 // static inline sfMouseWheelScrollEvent get_sfMouseWheelScrollEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->mouseWheelScroll;
 // }
 //
+//
+// static inline void set_sfMouseWheelScrollEvent_in_sfEvent_union(sfEvent* a, sfMouseWheelScrollEvent value) {
+//     a->mouseWheelScroll = value;
+// }
+//
 // 
 // static inline sfTouchEvent get_sfTouchEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->touch;
 // }
 //
+//
+// static inline void set_sfTouchEvent_in_sfEvent_union(sfEvent* a, sfTouchEvent value) {
+//     a->touch = value;
+// }
+//
 // 
 // static inline sfSensorEvent get_sfSensorEvent_from_sfEvent_union(const sfEvent* a) {
 //     return a->sensor;
 // }
 //
 //
+// static inline void set_sfSensorEvent_in_sfEvent_union(sfEvent* a, sfSensorEvent value) {
+//     a->sensor = value;
+// }
+//
+//
 import "C"
+import "fmt"
+import "strconv"
+import "strings"
 import "unsafe"
+import "time"
 
 
 func boolToSfBool(b bool) C.sfBool {
@@ -235,6 +284,118 @@ func sfBoolToBool(b C.sfBool) bool {
 	return false
 }
 
+func enumString[T ~int32](value T, values []T, names []string, typeName string) string {
+	for i, v := range values {
+		if v == value {
+			return names[i]
+		}
+	}
+	return typeName + "(" + strconv.FormatInt(int64(value), 10) + ")"
+}
+
+func parseEnum[T ~int32](s string, values []T, names []string, typeName string) (T, error) {
+	for i, name := range names {
+		if name == s {
+			return values[i], nil
+		}
+	}
+	return 0, fmt.Errorf("sfml: unknown %s %q", typeName, s)
+}
+
+func enumIsValid[T ~int32](value T, values []T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func flagsString[T ~uint32](value T, flags []T, names []string) string {
+	var set []string
+	rest := value
+	for i, flag := range flags {
+		if flag == 0 && value == 0 {
+			return names[i]
+		}
+		if flag != 0 && flag&(flag-1) == 0 && rest&flag != 0 {
+			set = append(set, names[i])
+			rest &^= flag
+		}
+	}
+	if rest != 0 {
+		set = append(set, "0x"+strconv.FormatUint(uint64(rest), 16))
+	}
+	if len(set) == 0 {
+		return "0"
+	}
+	return strings.Join(set, "|")
+}
+
+func parseFlags[T ~uint32](s string, flags []T, names []string, typeName string) (T, error) {
+	var value T
+	for _, part := range strings.Split(s, "|") {
+		part = strings.TrimSpace(part)
+		found := false
+		for i, name := range names {
+			if name == part {
+				value |= flags[i]
+				found = true
+				break
+			}
+		}
+		if !found {
+			bits, err := strconv.ParseUint(part, 0, 32)
+			if err != nil {
+				return 0, fmt.Errorf("sfml: unknown %s %q", typeName, part)
+			}
+			value |= T(bits)
+		}
+	}
+	return value, nil
+}
+
+func flagsIsValid[T ~uint32](value T, flags []T) bool {
+	var known T
+	for _, flag := range flags {
+		known |= flag
+	}
+	return value&^known == 0
+}
+
+func stringToUTF32(s string) unsafe.Pointer {
+	runes := []rune(s)
+	ptr := C.malloc(C.size_t(len(runes)+1) * C.size_t(unsafe.Sizeof(C.sfUint32(0))))
+	if ptr == nil {
+		panic("C.malloc failed")
+	}
+	buf := unsafe.Slice((*C.sfUint32)(ptr), len(runes)+1)
+	for i, r := range runes {
+		buf[i] = C.sfUint32(r)
+	}
+	buf[len(runes)] = 0
+	return ptr
+}
+
+func utf32ToString(ptr unsafe.Pointer) string {
+	if ptr == nil {
+		return ""
+	}
+	var runes []rune
+	for p := (*C.sfUint32)(ptr); *p != 0; p = (*C.sfUint32)(unsafe.Add(unsafe.Pointer(p), unsafe.Sizeof(*p))) {
+		runes = append(runes, rune(*p))
+	}
+	return string(runes)
+}
+
+func durationToSfTime(d time.Duration) C.sfTime {
+	return C.sfTime{microseconds: C.sfInt64(d.Microseconds())}
+}
+
+func sfTimeToDuration(t C.sfTime) time.Duration {
+	return time.Duration(t.microseconds) * time.Microsecond
+}
+
 type BlendEquation int32
 
 const (
@@ -245,6 +406,42 @@ const (
 	BlendEquationMax BlendEquation = C.sfBlendEquationMax
 )
 
+var blendEquationValues = []BlendEquation{BlendEquationAdd, BlendEquationSubtract, BlendEquationReverseSubtract, BlendEquationMin, BlendEquationMax}
+
+var blendEquationNames = []string{"BlendEquationAdd", "BlendEquationSubtract", "BlendEquationReverseSubtract", "BlendEquationMin", "BlendEquationMax"}
+
+func (b BlendEquation) String() string {
+	return enumString(b, blendEquationValues, blendEquationNames, "BlendEquation")
+}
+
+func ParseBlendEquation(s string) (BlendEquation, error) {
+	return parseEnum(s, blendEquationValues, blendEquationNames, "BlendEquation")
+}
+
+func BlendEquationValues() []BlendEquation {
+	return append([]BlendEquation(nil), blendEquationValues[:5]...)
+}
+
+func (b BlendEquation) IsValid() bool {
+	return enumIsValid(b, blendEquationValues[:5])
+}
+
+func (b BlendEquation) MarshalText() ([]byte, error) {
+	if !b.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid BlendEquation %d", b)
+	}
+	return []byte(b.String()), nil
+}
+
+func (b *BlendEquation) UnmarshalText(text []byte) error {
+	value, err := ParseBlendEquation(string(text))
+	if err != nil {
+		return err
+	}
+	*b = value
+	return nil
+}
+
 type BlendFactor int32
 
 const (
@@ -260,6 +457,42 @@ const (
 	BlendFactorOneMinusDstAlpha BlendFactor = C.sfBlendFactorOneMinusDstAlpha
 )
 
+var blendFactorValues = []BlendFactor{BlendFactorZero, BlendFactorOne, BlendFactorSrcColor, BlendFactorOneMinusSrcColor, BlendFactorDstColor, BlendFactorOneMinusDstColor, BlendFactorSrcAlpha, BlendFactorOneMinusSrcAlpha, BlendFactorDstAlpha, BlendFactorOneMinusDstAlpha}
+
+var blendFactorNames = []string{"BlendFactorZero", "BlendFactorOne", "BlendFactorSrcColor", "BlendFactorOneMinusSrcColor", "BlendFactorDstColor", "BlendFactorOneMinusDstColor", "BlendFactorSrcAlpha", "BlendFactorOneMinusSrcAlpha", "BlendFactorDstAlpha", "BlendFactorOneMinusDstAlpha"}
+
+func (b BlendFactor) String() string {
+	return enumString(b, blendFactorValues, blendFactorNames, "BlendFactor")
+}
+
+func ParseBlendFactor(s string) (BlendFactor, error) {
+	return parseEnum(s, blendFactorValues, blendFactorNames, "BlendFactor")
+}
+
+func BlendFactorValues() []BlendFactor {
+	return append([]BlendFactor(nil), blendFactorValues[:10]...)
+}
+
+func (b BlendFactor) IsValid() bool {
+	return enumIsValid(b, blendFactorValues[:10])
+}
+
+func (b BlendFactor) MarshalText() ([]byte, error) {
+	if !b.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid BlendFactor %d", b)
+	}
+	return []byte(b.String()), nil
+}
+
+func (b *BlendFactor) UnmarshalText(text []byte) error {
+	value, err := ParseBlendFactor(string(text))
+	if err != nil {
+		return err
+	}
+	*b = value
+	return nil
+}
+
 type BlendMode struct {
 	ColorSrcFactor BlendFactor
 	ColorDstFactor BlendFactor
@@ -404,7 +637,7 @@ func NewContextFromC(cPtr *C.sfContext) *Context {
 	return &Context{ptr: cPtr}
 }
 
-type ContextAttribute int32
+type ContextAttribute uint32
 
 const (
 	ContextDefault ContextAttribute = C.sfContextDefault
@@ -412,13 +645,61 @@ const (
 	ContextDebug ContextAttribute = C.sfContextDebug
 )
 
+var contextAttributeValues = []ContextAttribute{ContextDefault, ContextCore, ContextDebug}
+
+var contextAttributeNames = []string{"ContextDefault", "ContextCore", "ContextDebug"}
+
+func (c ContextAttribute) Has(flag ContextAttribute) bool {
+	return c&flag == flag
+}
+
+func (c ContextAttribute) With(flag ContextAttribute) ContextAttribute {
+	return c | flag
+}
+
+func (c ContextAttribute) Without(flag ContextAttribute) ContextAttribute {
+	return c &^ flag
+}
+
+func (c ContextAttribute) String() string {
+	return flagsString(c, contextAttributeValues, contextAttributeNames)
+}
+
+func ParseContextAttribute(s string) (ContextAttribute, error) {
+	return parseFlags(s, contextAttributeValues, contextAttributeNames, "ContextAttribute")
+}
+
+func ContextAttributeValues() []ContextAttribute {
+	return append([]ContextAttribute(nil), contextAttributeValues[:3]...)
+}
+
+func (c ContextAttribute) IsValid() bool {
+	return flagsIsValid(c, contextAttributeValues[:3])
+}
+
+func (c ContextAttribute) MarshalText() ([]byte, error) {
+	if !c.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid ContextAttribute %d", c)
+	}
+	return []byte(c.String()), nil
+}
+
+func (c *ContextAttribute) UnmarshalText(text []byte) error {
+	value, err := ParseContextAttribute(string(text))
+	if err != nil {
+		return err
+	}
+	*c = value
+	return nil
+}
+
 type ContextSettings struct {
 	DepthBits uint32
 	StencilBits uint32
 	AntialiasingLevel uint32
 	MajorVersion uint32
 	MinorVersion uint32
-	AttributeFlags uint32
+	AttributeFlags ContextAttribute
 	SRgbCapable bool
 }
 
@@ -428,7 +709,7 @@ func (c *ContextSettings) ToC() C.sfContextSettings {
 }
 
 func NewContextSettingsFromC(cObj C.sfContextSettings) *ContextSettings {
-	return &ContextSettings{ DepthBits: uint32(cObj.depthBits), StencilBits: uint32(cObj.stencilBits), AntialiasingLevel: uint32(cObj.antialiasingLevel), MajorVersion: uint32(cObj.majorVersion), MinorVersion: uint32(cObj.minorVersion), AttributeFlags: uint32(cObj.attributeFlags), SRgbCapable: sfBoolToBool(cObj.sRgbCapable) }
+	return &ContextSettings{ DepthBits: uint32(cObj.depthBits), StencilBits: uint32(cObj.stencilBits), AntialiasingLevel: uint32(cObj.antialiasingLevel), MajorVersion: uint32(cObj.majorVersion), MinorVersion: uint32(cObj.minorVersion), AttributeFlags: ContextAttribute(cObj.attributeFlags), SRgbCapable: sfBoolToBool(cObj.sRgbCapable) }
 }
 
 func NewContextSettingsSliceFromCArray(ptr *C.sfContextSettings, count C.size_t) []ContextSettings {
@@ -512,6 +793,42 @@ const (
 	CursorNotAllowed CursorType = C.sfCursorNotAllowed
 )
 
+var cursorTypeValues = []CursorType{CursorArrow, CursorArrowWait, CursorWait, CursorText, CursorHand, CursorSizeHorizontal, CursorSizeVertical, CursorSizeTopLeftBottomRight, CursorSizeBottomLeftTopRight, CursorSizeLeft, CursorSizeRight, CursorSizeTop, CursorSizeBottom, CursorSizeTopLeft, CursorSizeBottomRight, CursorSizeBottomLeft, CursorSizeTopRight, CursorSizeAll, CursorCross, CursorHelp, CursorNotAllowed}
+
+var cursorTypeNames = []string{"CursorArrow", "CursorArrowWait", "CursorWait", "CursorText", "CursorHand", "CursorSizeHorizontal", "CursorSizeVertical", "CursorSizeTopLeftBottomRight", "CursorSizeBottomLeftTopRight", "CursorSizeLeft", "CursorSizeRight", "CursorSizeTop", "CursorSizeBottom", "CursorSizeTopLeft", "CursorSizeBottomRight", "CursorSizeBottomLeft", "CursorSizeTopRight", "CursorSizeAll", "CursorCross", "CursorHelp", "CursorNotAllowed"}
+
+func (c CursorType) String() string {
+	return enumString(c, cursorTypeValues, cursorTypeNames, "CursorType")
+}
+
+func ParseCursorType(s string) (CursorType, error) {
+	return parseEnum(s, cursorTypeValues, cursorTypeNames, "CursorType")
+}
+
+func CursorTypeValues() []CursorType {
+	return append([]CursorType(nil), cursorTypeValues[:21]...)
+}
+
+func (c CursorType) IsValid() bool {
+	return enumIsValid(c, cursorTypeValues[:21])
+}
+
+func (c CursorType) MarshalText() ([]byte, error) {
+	if !c.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid CursorType %d", c)
+	}
+	return []byte(c.String()), nil
+}
+
+func (c *CursorType) UnmarshalText(text []byte) error {
+	value, err := ParseCursorType(string(text))
+	if err != nil {
+		return err
+	}
+	*c = value
+	return nil
+}
+
 type Event interface {
 	EventType() EventType
 	BaseToC() C.sfEvent
@@ -669,6 +986,238 @@ func (s *SensorEvent) BaseToC() C.sfEvent {
 	return s.BaseEvent.cObj
 }
 
+func newEventOfType(eventType EventType) Event {
+	switch eventType {
+	case EvtClosed:
+		return &ClosedEvent{Type: eventType}
+	case EvtLostFocus:
+		return &LostFocusEvent{Type: eventType}
+	case EvtGainedFocus:
+		return &GainedFocusEvent{Type: eventType}
+	case EvtMouseEntered:
+		return &MouseEnteredEvent{Type: eventType}
+	case EvtMouseLeft:
+		return &MouseLeftEvent{Type: eventType}
+	case EvtResized:
+		return &SizeEvent{Type: eventType}
+	case EvtKeyPressed, EvtKeyReleased:
+		return &KeyEvent{Type: eventType}
+	case EvtTextEntered:
+		return &TextEvent{Type: eventType}
+	case EvtMouseMoved:
+		return &MouseMoveEvent{Type: eventType}
+	case EvtMouseButtonPressed, EvtMouseButtonReleased:
+		return &MouseButtonEvent{Type: eventType}
+	case EvtMouseWheelMoved:
+		return &MouseWheelEvent{Type: eventType}
+	case EvtMouseWheelScrolled:
+		return &MouseWheelScrollEvent{Type: eventType}
+	case EvtTouchBegan, EvtTouchMoved, EvtTouchEnded:
+		return &TouchEvent{Type: eventType}
+	case EvtSensorChanged:
+		return &SensorEvent{Type: eventType}
+	default:
+		return nil
+	}
+}
+
+func eventToC(value Event) C.sfEvent {
+	var cObj C.sfEvent
+	switch value := value.(type) {
+	case *ClosedEvent:
+		C.set_sfEvent_type(&cObj, C.sfEventType(value.Type))
+	case *LostFocusEvent:
+		C.set_sfEvent_type(&cObj, C.sfEventType(value.Type))
+	case *GainedFocusEvent:
+		C.set_sfEvent_type(&cObj, C.sfEventType(value.Type))
+	case *MouseEnteredEvent:
+		C.set_sfEvent_type(&cObj, C.sfEventType(value.Type))
+	case *MouseLeftEvent:
+		C.set_sfEvent_type(&cObj, C.sfEventType(value.Type))
+	case *SizeEvent:
+		C.set_sfSizeEvent_in_sfEvent_union(&cObj, value.ToC())
+	case *KeyEvent:
+		C.set_sfKeyEvent_in_sfEvent_union(&cObj, value.ToC())
+	case *TextEvent:
+		C.set_sfTextEvent_in_sfEvent_union(&cObj, value.ToC())
+	case *MouseMoveEvent:
+		C.set_sfMouseMoveEvent_in_sfEvent_union(&cObj, value.ToC())
+	case *MouseButtonEvent:
+		C.set_sfMouseButtonEvent_in_sfEvent_union(&cObj, value.ToC())
+	case *MouseWheelEvent:
+		C.set_sfMouseWheelEvent_in_sfEvent_union(&cObj, value.ToC())
+	case *MouseWheelScrollEvent:
+		C.set_sfMouseWheelScrollEvent_in_sfEvent_union(&cObj, value.ToC())
+	case *TouchEvent:
+		C.set_sfTouchEvent_in_sfEvent_union(&cObj, value.ToC())
+	case *SensorEvent:
+		C.set_sfSensorEvent_in_sfEvent_union(&cObj, value.ToC())
+	}
+	return cObj
+}
+
+type EventHandler struct {
+	dispatcher *EventDispatcher
+	eventType EventType
+	handle func(Event)
+	consume bool
+}
+
+func (e *EventHandler) Consume(consume bool) *EventHandler {
+	e.consume = consume
+	return e
+}
+
+func (e *EventHandler) Unsubscribe() {
+	handlers := e.dispatcher.handlers[e.eventType]
+	for i, handler := range handlers {
+		if handler == e {
+			// Copy, so a Dispatch in progress keeps iterating the old slice
+			e.dispatcher.handlers[e.eventType] = append(handlers[:i:i], handlers[i+1:]...)
+			return
+		}
+	}
+}
+
+type EventDispatcher struct {
+	handlers map[EventType][]*EventHandler
+}
+
+func NewEventDispatcher() *EventDispatcher {
+	return &EventDispatcher{handlers: make(map[EventType][]*EventHandler)}
+}
+
+func (e *EventDispatcher) Dispatch(event Event) bool {
+	if event == nil {
+		return false
+	}
+	for _, handler := range e.handlers[event.EventType()] {
+		handler.handle(event)
+		if handler.consume {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *EventDispatcher) subscribe(eventType EventType, handle func(Event)) *EventHandler {
+	// Created here, so the zero value of the dispatcher is ready to use
+	if e.handlers == nil {
+		e.handlers = make(map[EventType][]*EventHandler)
+	}
+	handler := &EventHandler{dispatcher: e, eventType: eventType, handle: handle}
+	e.handlers[eventType] = append(e.handlers[eventType], handler)
+	return handler
+}
+
+func (e *EventDispatcher) OnClosed(handler func(*ClosedEvent)) *EventHandler {
+	return e.subscribe(EvtClosed, func(event Event) {
+		handler(event.(*ClosedEvent))
+	})
+}
+
+func (e *EventDispatcher) OnLostFocus(handler func(*LostFocusEvent)) *EventHandler {
+	return e.subscribe(EvtLostFocus, func(event Event) {
+		handler(event.(*LostFocusEvent))
+	})
+}
+
+func (e *EventDispatcher) OnGainedFocus(handler func(*GainedFocusEvent)) *EventHandler {
+	return e.subscribe(EvtGainedFocus, func(event Event) {
+		handler(event.(*GainedFocusEvent))
+	})
+}
+
+func (e *EventDispatcher) OnMouseEntered(handler func(*MouseEnteredEvent)) *EventHandler {
+	return e.subscribe(EvtMouseEntered, func(event Event) {
+		handler(event.(*MouseEnteredEvent))
+	})
+}
+
+func (e *EventDispatcher) OnMouseLeft(handler func(*MouseLeftEvent)) *EventHandler {
+	return e.subscribe(EvtMouseLeft, func(event Event) {
+		handler(event.(*MouseLeftEvent))
+	})
+}
+
+func (e *EventDispatcher) OnResized(handler func(*SizeEvent)) *EventHandler {
+	return e.subscribe(EvtResized, func(event Event) {
+		handler(event.(*SizeEvent))
+	})
+}
+
+func (e *EventDispatcher) OnKeyPressed(handler func(*KeyEvent)) *EventHandler {
+	return e.subscribe(EvtKeyPressed, func(event Event) {
+		handler(event.(*KeyEvent))
+	})
+}
+
+func (e *EventDispatcher) OnKeyReleased(handler func(*KeyEvent)) *EventHandler {
+	return e.subscribe(EvtKeyReleased, func(event Event) {
+		handler(event.(*KeyEvent))
+	})
+}
+
+func (e *EventDispatcher) OnTextEntered(handler func(*TextEvent)) *EventHandler {
+	return e.subscribe(EvtTextEntered, func(event Event) {
+		handler(event.(*TextEvent))
+	})
+}
+
+func (e *EventDispatcher) OnMouseMoved(handler func(*MouseMoveEvent)) *EventHandler {
+	return e.subscribe(EvtMouseMoved, func(event Event) {
+		handler(event.(*MouseMoveEvent))
+	})
+}
+
+func (e *EventDispatcher) OnMouseButtonPressed(handler func(*MouseButtonEvent)) *EventHandler {
+	return e.subscribe(EvtMouseButtonPressed, func(event Event) {
+		handler(event.(*MouseButtonEvent))
+	})
+}
+
+func (e *EventDispatcher) OnMouseButtonReleased(handler func(*MouseButtonEvent)) *EventHandler {
+	return e.subscribe(EvtMouseButtonReleased, func(event Event) {
+		handler(event.(*MouseButtonEvent))
+	})
+}
+
+func (e *EventDispatcher) OnMouseWheelMoved(handler func(*MouseWheelEvent)) *EventHandler {
+	return e.subscribe(EvtMouseWheelMoved, func(event Event) {
+		handler(event.(*MouseWheelEvent))
+	})
+}
+
+func (e *EventDispatcher) OnMouseWheelScrolled(handler func(*MouseWheelScrollEvent)) *EventHandler {
+	return e.subscribe(EvtMouseWheelScrolled, func(event Event) {
+		handler(event.(*MouseWheelScrollEvent))
+	})
+}
+
+func (e *EventDispatcher) OnTouchBegan(handler func(*TouchEvent)) *EventHandler {
+	return e.subscribe(EvtTouchBegan, func(event Event) {
+		handler(event.(*TouchEvent))
+	})
+}
+
+func (e *EventDispatcher) OnTouchMoved(handler func(*TouchEvent)) *EventHandler {
+	return e.subscribe(EvtTouchMoved, func(event Event) {
+		handler(event.(*TouchEvent))
+	})
+}
+
+func (e *EventDispatcher) OnTouchEnded(handler func(*TouchEvent)) *EventHandler {
+	return e.subscribe(EvtTouchEnded, func(event Event) {
+		handler(event.(*TouchEvent))
+	})
+}
+
+func (e *EventDispatcher) OnSensorChanged(handler func(*SensorEvent)) *EventHandler {
+	return e.subscribe(EvtSensorChanged, func(event Event) {
+		handler(event.(*SensorEvent))
+	})
+}
+
 type EventType int32
 
 const (
@@ -698,6 +1247,45 @@ const (
 	EvtCount EventType = C.sfEvtCount
 )
 
+var eventTypeValues = []EventType{EvtClosed, EvtResized, EvtLostFocus, EvtGainedFocus, EvtTextEntered, EvtKeyPressed, EvtKeyReleased, EvtMouseWheelMoved, EvtMouseWheelScrolled, EvtMouseButtonPressed, EvtMouseButtonReleased, EvtMouseMoved, EvtMouseEntered, EvtMouseLeft, EvtJoystickButtonPressed, EvtJoystickButtonReleased, EvtJoystickMoved, EvtJoystickConnected, EvtJoystickDisconnected, EvtTouchBegan, EvtTouchMoved, EvtTouchEnded, EvtSensorChanged, EvtCount}
+
+var eventTypeNames = []string{"EvtClosed", "EvtResized", "EvtLostFocus", "EvtGainedFocus", "EvtTextEntered", "EvtKeyPressed", "EvtKeyReleased", "EvtMouseWheelMoved", "EvtMouseWheelScrolled", "EvtMouseButtonPressed", "EvtMouseButtonReleased", "EvtMouseMoved", "EvtMouseEntered", "EvtMouseLeft", "EvtJoystickButtonPressed", "EvtJoystickButtonReleased", "EvtJoystickMoved", "EvtJoystickConnected", "EvtJoystickDisconnected", "EvtTouchBegan", "EvtTouchMoved", "EvtTouchEnded", "EvtSensorChanged", "EvtCount"}
+
+func (e EventType) String() string {
+	return enumString(e, eventTypeValues, eventTypeNames, "EventType")
+}
+
+func ParseEventType(s string) (EventType, error) {
+	if s == "EvtCount" {
+		return 0, fmt.Errorf("sfml: unknown EventType %q", s)
+	}
+	return parseEnum(s, eventTypeValues, eventTypeNames, "EventType")
+}
+
+func EventTypeValues() []EventType {
+	return append([]EventType(nil), eventTypeValues[:23]...)
+}
+
+func (e EventType) IsValid() bool {
+	return enumIsValid(e, eventTypeValues[:23])
+}
+
+func (e EventType) MarshalText() ([]byte, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid EventType %d", e)
+	}
+	return []byte(e.String()), nil
+}
+
+func (e *EventType) UnmarshalText(text []byte) error {
+	value, err := ParseEventType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type FloatRect struct {
 	Left float32
 	Top float32
@@ -1033,27 +1621,91 @@ func NewVector4iCArrayFromGoSlice(slice []Vector4i) *C.sfGlslIvec4 {
 }
 
 type GlslMat3 struct {
-	ptr *C.sfGlslMat3
+	Array [9]float32
+}
+
+func (g *GlslMat3) ToC() C.sfGlslMat3 {
+	funcRes := C.sfGlslMat3{ array: *(*[9]C.float)(unsafe.Pointer(&g.Array)) }
+	return funcRes
 }
 
-func (g *GlslMat3) ToC() *C.sfGlslMat3 {
-	return g.ptr
+func NewGlslMat3FromC(cObj C.sfGlslMat3) *GlslMat3 {
+	return &GlslMat3{ Array: *(*[9]float32)(unsafe.Pointer(&cObj.array)) }
 }
 
-func NewGlslMat3FromC(cPtr *C.sfGlslMat3) *GlslMat3 {
-	return &GlslMat3{ptr: cPtr}
+func NewGlslMat3SliceFromCArray(ptr *C.sfGlslMat3, count C.size_t) []GlslMat3 {
+	if unsafe.Sizeof(GlslMat3{}) != unsafe.Sizeof(C.sfGlslMat3{}) {
+		panic("Size mismatch between Go and C types")
+	}
+	
+	goSlice := make([]GlslMat3, int(count))
+	src := unsafe.Pointer(ptr)
+	dst := unsafe.Pointer(&goSlice[0])
+	size := int(count) * int(unsafe.Sizeof(GlslMat3{}))
+	copy((*[1 << 30]byte)(dst)[:size:size], (*[1 << 30]byte)(src)[:size:size])
+	return goSlice
+}
+
+func NewGlslMat3CArrayFromGoSlice(slice []GlslMat3) *C.sfGlslMat3 {
+	if unsafe.Sizeof(GlslMat3{}) != unsafe.Sizeof(C.sfGlslMat3{}) {
+		panic("Size mismatch between Go and C types")
+	}
+	
+	if len(slice) == 0 {
+		return nil
+	}
+	size := uintptr(len(slice)) * unsafe.Sizeof(GlslMat3{})
+	ptr := C.malloc(C.size_t(size))
+	if ptr == nil {
+		panic("C.malloc failed")
+	}
+	src := unsafe.Pointer(&slice[0])
+	C.memcpy(ptr, src, C.size_t(size))
+	return (*C.sfGlslMat3)(ptr)
 }
 
 type GlslMat4 struct {
-	ptr *C.sfGlslMat4
+	Array [16]float32
 }
 
-func (g *GlslMat4) ToC() *C.sfGlslMat4 {
-	return g.ptr
+func (g *GlslMat4) ToC() C.sfGlslMat4 {
+	funcRes := C.sfGlslMat4{ array: *(*[16]C.float)(unsafe.Pointer(&g.Array)) }
+	return funcRes
 }
 
-func NewGlslMat4FromC(cPtr *C.sfGlslMat4) *GlslMat4 {
-	return &GlslMat4{ptr: cPtr}
+func NewGlslMat4FromC(cObj C.sfGlslMat4) *GlslMat4 {
+	return &GlslMat4{ Array: *(*[16]float32)(unsafe.Pointer(&cObj.array)) }
+}
+
+func NewGlslMat4SliceFromCArray(ptr *C.sfGlslMat4, count C.size_t) []GlslMat4 {
+	if unsafe.Sizeof(GlslMat4{}) != unsafe.Sizeof(C.sfGlslMat4{}) {
+		panic("Size mismatch between Go and C types")
+	}
+	
+	goSlice := make([]GlslMat4, int(count))
+	src := unsafe.Pointer(ptr)
+	dst := unsafe.Pointer(&goSlice[0])
+	size := int(count) * int(unsafe.Sizeof(GlslMat4{}))
+	copy((*[1 << 30]byte)(dst)[:size:size], (*[1 << 30]byte)(src)[:size:size])
+	return goSlice
+}
+
+func NewGlslMat4CArrayFromGoSlice(slice []GlslMat4) *C.sfGlslMat4 {
+	if unsafe.Sizeof(GlslMat4{}) != unsafe.Sizeof(C.sfGlslMat4{}) {
+		panic("Size mismatch between Go and C types")
+	}
+	
+	if len(slice) == 0 {
+		return nil
+	}
+	size := uintptr(len(slice)) * unsafe.Sizeof(GlslMat4{})
+	ptr := C.malloc(C.size_t(size))
+	if ptr == nil {
+		panic("C.malloc failed")
+	}
+	src := unsafe.Pointer(&slice[0])
+	C.memcpy(ptr, src, C.size_t(size))
+	return (*C.sfGlslMat4)(ptr)
 }
 
 type Vector4f struct {
@@ -1335,6 +1987,45 @@ const (
 	KeyQuote KeyCode = C.sfKeyQuote
 )
 
+var keyCodeValues = []KeyCode{KeyUnknown, KeyA, KeyB, KeyC, KeyD, KeyE, KeyF, KeyG, KeyH, KeyI, KeyJ, KeyK, KeyL, KeyM, KeyN, KeyO, KeyP, KeyQ, KeyR, KeyS, KeyT, KeyU, KeyV, KeyW, KeyX, KeyY, KeyZ, KeyNum0, KeyNum1, KeyNum2, KeyNum3, KeyNum4, KeyNum5, KeyNum6, KeyNum7, KeyNum8, KeyNum9, KeyEscape, KeyLcOntrol, KeyLsHift, KeyLaLt, KeyLsYstem, KeyRcOntrol, KeyRsHift, KeyRaLt, KeyRsYstem, KeyMenu, KeyLbRacket, KeyRbRacket, KeySemicolon, KeyComma, KeyPeriod, KeyApostrophe, KeySlash, KeyBackslash, KeyGrave, KeyEqual, KeyHyphen, KeySpace, KeyEnter, KeyBackspace, KeyTab, KeyPageUp, KeyPageDown, KeyEnd, KeyHome, KeyInsert, KeyDelete, KeyAdd, KeySubtract, KeyMultiply, KeyDivide, KeyLeft, KeyRight, KeyUp, KeyDown, KeyNumpad0, KeyNumpad1, KeyNumpad2, KeyNumpad3, KeyNumpad4, KeyNumpad5, KeyNumpad6, KeyNumpad7, KeyNumpad8, KeyNumpad9, KeyF1, KeyF2, KeyF3, KeyF4, KeyF5, KeyF6, KeyF7, KeyF8, KeyF9, KeyF10, KeyF11, KeyF12, KeyF13, KeyF14, KeyF15, KeyPause, KeyCount, KeyTilde, KeyDash, KeyBack, KeyBackSlash, KeySemiColon, KeyReturn, KeyQuote}
+
+var keyCodeNames = []string{"KeyUnknown", "KeyA", "KeyB", "KeyC", "KeyD", "KeyE", "KeyF", "KeyG", "KeyH", "KeyI", "KeyJ", "KeyK", "KeyL", "KeyM", "KeyN", "KeyO", "KeyP", "KeyQ", "KeyR", "KeyS", "KeyT", "KeyU", "KeyV", "KeyW", "KeyX", "KeyY", "KeyZ", "KeyNum0", "KeyNum1", "KeyNum2", "KeyNum3", "KeyNum4", "KeyNum5", "KeyNum6", "KeyNum7", "KeyNum8", "KeyNum9", "KeyEscape", "KeyLcOntrol", "KeyLsHift", "KeyLaLt", "KeyLsYstem", "KeyRcOntrol", "KeyRsHift", "KeyRaLt", "KeyRsYstem", "KeyMenu", "KeyLbRacket", "KeyRbRacket", "KeySemicolon", "KeyComma", "KeyPeriod", "KeyApostrophe", "KeySlash", "KeyBackslash", "KeyGrave", "KeyEqual", "KeyHyphen", "KeySpace", "KeyEnter", "KeyBackspace", "KeyTab", "KeyPageUp", "KeyPageDown", "KeyEnd", "KeyHome", "KeyInsert", "KeyDelete", "KeyAdd", "KeySubtract", "KeyMultiply", "KeyDivide", "KeyLeft", "KeyRight", "KeyUp", "KeyDown", "KeyNumpad0", "KeyNumpad1", "KeyNumpad2", "KeyNumpad3", "KeyNumpad4", "KeyNumpad5", "KeyNumpad6", "KeyNumpad7", "KeyNumpad8", "KeyNumpad9", "KeyF1", "KeyF2", "KeyF3", "KeyF4", "KeyF5", "KeyF6", "KeyF7", "KeyF8", "KeyF9", "KeyF10", "KeyF11", "KeyF12", "KeyF13", "KeyF14", "KeyF15", "KeyPause", "KeyCount", "KeyTilde", "KeyDash", "KeyBack", "KeyBackSlash", "KeySemiColon", "KeyReturn", "KeyQuote"}
+
+func (k KeyCode) String() string {
+	return enumString(k, keyCodeValues, keyCodeNames, "KeyCode")
+}
+
+func ParseKeyCode(s string) (KeyCode, error) {
+	if s == "KeyCount" {
+		return 0, fmt.Errorf("sfml: unknown KeyCode %q", s)
+	}
+	return parseEnum(s, keyCodeValues, keyCodeNames, "KeyCode")
+}
+
+func KeyCodeValues() []KeyCode {
+	return append([]KeyCode(nil), keyCodeValues[:102]...)
+}
+
+func (k KeyCode) IsValid() bool {
+	return enumIsValid(k, keyCodeValues[:102])
+}
+
+func (k KeyCode) MarshalText() ([]byte, error) {
+	if !k.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid KeyCode %d", k)
+	}
+	return []byte(k.String()), nil
+}
+
+func (k *KeyCode) UnmarshalText(text []byte) error {
+	value, err := ParseKeyCode(string(text))
+	if err != nil {
+		return err
+	}
+	*k = value
+	return nil
+}
+
 type KeyEvent struct {
 	BaseEvent
 	Type EventType
@@ -1398,6 +2089,45 @@ const (
 	MouseButtonCount MouseButton = C.sfMouseButtonCount
 )
 
+var mouseButtonValues = []MouseButton{MouseLeft, MouseRight, MouseMiddle, MouseXbUtton1, MouseXbUtton2, MouseButtonCount}
+
+var mouseButtonNames = []string{"MouseLeft", "MouseRight", "MouseMiddle", "MouseXbUtton1", "MouseXbUtton2", "MouseButtonCount"}
+
+func (m MouseButton) String() string {
+	return enumString(m, mouseButtonValues, mouseButtonNames, "MouseButton")
+}
+
+func ParseMouseButton(s string) (MouseButton, error) {
+	if s == "MouseButtonCount" {
+		return 0, fmt.Errorf("sfml: unknown MouseButton %q", s)
+	}
+	return parseEnum(s, mouseButtonValues, mouseButtonNames, "MouseButton")
+}
+
+func MouseButtonValues() []MouseButton {
+	return append([]MouseButton(nil), mouseButtonValues[:5]...)
+}
+
+func (m MouseButton) IsValid() bool {
+	return enumIsValid(m, mouseButtonValues[:5])
+}
+
+func (m MouseButton) MarshalText() ([]byte, error) {
+	if !m.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid MouseButton %d", m)
+	}
+	return []byte(m.String()), nil
+}
+
+func (m *MouseButton) UnmarshalText(text []byte) error {
+	value, err := ParseMouseButton(string(text))
+	if err != nil {
+		return err
+	}
+	*m = value
+	return nil
+}
+
 type MouseButtonEvent struct {
 	BaseEvent
 	Type EventType
@@ -1502,6 +2232,42 @@ const (
 	MouseHorizontalWheel MouseWheel = C.sfMouseHorizontalWheel
 )
 
+var mouseWheelValues = []MouseWheel{MouseVerticalWheel, MouseHorizontalWheel}
+
+var mouseWheelNames = []string{"MouseVerticalWheel", "MouseHorizontalWheel"}
+
+func (m MouseWheel) String() string {
+	return enumString(m, mouseWheelValues, mouseWheelNames, "MouseWheel")
+}
+
+func ParseMouseWheel(s string) (MouseWheel, error) {
+	return parseEnum(s, mouseWheelValues, mouseWheelNames, "MouseWheel")
+}
+
+func MouseWheelValues() []MouseWheel {
+	return append([]MouseWheel(nil), mouseWheelValues[:2]...)
+}
+
+func (m MouseWheel) IsValid() bool {
+	return enumIsValid(m, mouseWheelValues[:2])
+}
+
+func (m MouseWheel) MarshalText() ([]byte, error) {
+	if !m.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid MouseWheel %d", m)
+	}
+	return []byte(m.String()), nil
+}
+
+func (m *MouseWheel) UnmarshalText(text []byte) error {
+	value, err := ParseMouseWheel(string(text))
+	if err != nil {
+		return err
+	}
+	*m = value
+	return nil
+}
+
 type MouseWheelEvent struct {
 	BaseEvent
 	Type EventType
@@ -1628,6 +2394,42 @@ const (
 	TrianglesFan PrimitiveType = C.sfTrianglesFan
 )
 
+var primitiveTypeValues = []PrimitiveType{Points, Lines, LineStrip, Triangles, TriangleStrip, TriangleFan, Quads, LinesStrip, TrianglesStrip, TrianglesFan}
+
+var primitiveTypeNames = []string{"Points", "Lines", "LineStrip", "Triangles", "TriangleStrip", "TriangleFan", "Quads", "LinesStrip", "TrianglesStrip", "TrianglesFan"}
+
+func (p PrimitiveType) String() string {
+	return enumString(p, primitiveTypeValues, primitiveTypeNames, "PrimitiveType")
+}
+
+func ParsePrimitiveType(s string) (PrimitiveType, error) {
+	return parseEnum(s, primitiveTypeValues, primitiveTypeNames, "PrimitiveType")
+}
+
+func PrimitiveTypeValues() []PrimitiveType {
+	return append([]PrimitiveType(nil), primitiveTypeValues[:10]...)
+}
+
+func (p PrimitiveType) IsValid() bool {
+	return enumIsValid(p, primitiveTypeValues[:10])
+}
+
+func (p PrimitiveType) MarshalText() ([]byte, error) {
+	if !p.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid PrimitiveType %d", p)
+	}
+	return []byte(p.String()), nil
+}
+
+func (p *PrimitiveType) UnmarshalText(text []byte) error {
+	value, err := ParsePrimitiveType(string(text))
+	if err != nil {
+		return err
+	}
+	*p = value
+	return nil
+}
+
 type RectangleShape struct {
 	ptr *C.sfRectangleShape
 }
@@ -1864,6 +2666,45 @@ const (
 	ScancodeCount Scancode = C.sfScancodeCount
 )
 
+var scancodeValues = []Scancode{ScanUnknown, ScanA, ScanB, ScanC, ScanD, ScanE, ScanF, ScanG, ScanH, ScanI, ScanJ, ScanK, ScanL, ScanM, ScanN, ScanO, ScanP, ScanQ, ScanR, ScanS, ScanT, ScanU, ScanV, ScanW, ScanX, ScanY, ScanZ, ScanNum1, ScanNum2, ScanNum3, ScanNum4, ScanNum5, ScanNum6, ScanNum7, ScanNum8, ScanNum9, ScanNum0, ScanEnter, ScanEscape, ScanBackspace, ScanTab, ScanSpace, ScanHyphen, ScanEqual, ScanLbRacket, ScanRbRacket, ScanBackslash, ScanSemicolon, ScanApostrophe, ScanGrave, ScanComma, ScanPeriod, ScanSlash, ScanF1, ScanF2, ScanF3, ScanF4, ScanF5, ScanF6, ScanF7, ScanF8, ScanF9, ScanF10, ScanF11, ScanF12, ScanF13, ScanF14, ScanF15, ScanF16, ScanF17, ScanF18, ScanF19, ScanF20, ScanF21, ScanF22, ScanF23, ScanF24, ScanCapsLock, ScanPrintScreen, ScanScrollLock, ScanPause, ScanInsert, ScanHome, ScanPageUp, ScanDelete, ScanEnd, ScanPageDown, ScanRight, ScanLeft, ScanDown, ScanUp, ScanNumLock, ScanNumpadDivide, ScanNumpadMultiply, ScanNumpadMinus, ScanNumpadPlus, ScanNumpadEqual, ScanNumpadEnter, ScanNumpadDecimal, ScanNumpad1, ScanNumpad2, ScanNumpad3, ScanNumpad4, ScanNumpad5, ScanNumpad6, ScanNumpad7, ScanNumpad8, ScanNumpad9, ScanNumpad0, ScanNonUsBackslash, ScanApplication, ScanExecute, ScanModeChange, ScanHelp, ScanMenu, ScanSelect, ScanRedo, ScanUndo, ScanCut, ScanCopy, ScanPaste, ScanVolumeMute, ScanVolumeUp, ScanVolumeDown, ScanMediaPlayPause, ScanMediaStop, ScanMediaNextTrack, ScanMediaPreviousTrack, ScanLcOntrol, ScanLsHift, ScanLaLt, ScanLsYstem, ScanRcOntrol, ScanRsHift, ScanRaLt, ScanRsYstem, ScanBack, ScanForward, ScanRefresh, ScanStop, ScanSearch, ScanFavorites, ScanHomePage, ScanLaunchApplication1, ScanLaunchApplication2, ScanLaunchMail, ScanLaunchMediaSelect, ScancodeCount}
+
+var scancodeNames = []string{"ScanUnknown", "ScanA", "ScanB", "ScanC", "ScanD", "ScanE", "ScanF", "ScanG", "ScanH", "ScanI", "ScanJ", "ScanK", "ScanL", "ScanM", "ScanN", "ScanO", "ScanP", "ScanQ", "ScanR", "ScanS", "ScanT", "ScanU", "ScanV", "ScanW", "ScanX", "ScanY", "ScanZ", "ScanNum1", "ScanNum2", "ScanNum3", "ScanNum4", "ScanNum5", "ScanNum6", "ScanNum7", "ScanNum8", "ScanNum9", "ScanNum0", "ScanEnter", "ScanEscape", "ScanBackspace", "ScanTab", "ScanSpace", "ScanHyphen", "ScanEqual", "ScanLbRacket", "ScanRbRacket", "ScanBackslash", "ScanSemicolon", "ScanApostrophe", "ScanGrave", "ScanComma", "ScanPeriod", "ScanSlash", "ScanF1", "ScanF2", "ScanF3", "ScanF4", "ScanF5", "ScanF6", "ScanF7", "ScanF8", "ScanF9", "ScanF10", "ScanF11", "ScanF12", "ScanF13", "ScanF14", "ScanF15", "ScanF16", "ScanF17", "ScanF18", "ScanF19", "ScanF20", "ScanF21", "ScanF22", "ScanF23", "ScanF24", "ScanCapsLock", "ScanPrintScreen", "ScanScrollLock", "ScanPause", "ScanInsert", "ScanHome", "ScanPageUp", "ScanDelete", "ScanEnd", "ScanPageDown", "ScanRight", "ScanLeft", "ScanDown", "ScanUp", "ScanNumLock", "ScanNumpadDivide", "ScanNumpadMultiply", "ScanNumpadMinus", "ScanNumpadPlus", "ScanNumpadEqual", "ScanNumpadEnter", "ScanNumpadDecimal", "ScanNumpad1", "ScanNumpad2", "ScanNumpad3", "ScanNumpad4", "ScanNumpad5", "ScanNumpad6", "ScanNumpad7", "ScanNumpad8", "ScanNumpad9", "ScanNumpad0", "ScanNonUsBackslash", "ScanApplication", "ScanExecute", "ScanModeChange", "ScanHelp", "ScanMenu", "ScanSelect", "ScanRedo", "ScanUndo", "ScanCut", "ScanCopy", "ScanPaste", "ScanVolumeMute", "ScanVolumeUp", "ScanVolumeDown", "ScanMediaPlayPause", "ScanMediaStop", "ScanMediaNextTrack", "ScanMediaPreviousTrack", "ScanLcOntrol", "ScanLsHift", "ScanLaLt", "ScanLsYstem", "ScanRcOntrol", "ScanRsHift", "ScanRaLt", "ScanRsYstem", "ScanBack", "ScanForward", "ScanRefresh", "ScanStop", "ScanSearch", "ScanFavorites", "ScanHomePage", "ScanLaunchApplication1", "ScanLaunchApplication2", "ScanLaunchMail", "ScanLaunchMediaSelect", "ScancodeCount"}
+
+func (s Scancode) String() string {
+	return enumString(s, scancodeValues, scancodeNames, "Scancode")
+}
+
+func ParseScancode(s string) (Scancode, error) {
+	if s == "ScancodeCount" {
+		return 0, fmt.Errorf("sfml: unknown Scancode %q", s)
+	}
+	return parseEnum(s, scancodeValues, scancodeNames, "Scancode")
+}
+
+func ScancodeValues() []Scancode {
+	return append([]Scancode(nil), scancodeValues[:147]...)
+}
+
+func (s Scancode) IsValid() bool {
+	return enumIsValid(s, scancodeValues[:147])
+}
+
+func (s Scancode) MarshalText() ([]byte, error) {
+	if !s.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid Scancode %d", s)
+	}
+	return []byte(s.String()), nil
+}
+
+func (s *Scancode) UnmarshalText(text []byte) error {
+	value, err := ParseScancode(string(text))
+	if err != nil {
+		return err
+	}
+	*s = value
+	return nil
+}
+
 type SensorEvent struct {
 	BaseEvent
 	Type EventType
@@ -1926,6 +2767,45 @@ const (
 	SensorCount SensorType = C.sfSensorCount
 )
 
+var sensorTypeValues = []SensorType{SensorAccelerometer, SensorGyroscope, SensorMagnetometer, SensorGravity, SensorUserAcceleration, SensorOrientation, SensorCount}
+
+var sensorTypeNames = []string{"SensorAccelerometer", "SensorGyroscope", "SensorMagnetometer", "SensorGravity", "SensorUserAcceleration", "SensorOrientation", "SensorCount"}
+
+func (s SensorType) String() string {
+	return enumString(s, sensorTypeValues, sensorTypeNames, "SensorType")
+}
+
+func ParseSensorType(s string) (SensorType, error) {
+	if s == "SensorCount" {
+		return 0, fmt.Errorf("sfml: unknown SensorType %q", s)
+	}
+	return parseEnum(s, sensorTypeValues, sensorTypeNames, "SensorType")
+}
+
+func SensorTypeValues() []SensorType {
+	return append([]SensorType(nil), sensorTypeValues[:6]...)
+}
+
+func (s SensorType) IsValid() bool {
+	return enumIsValid(s, sensorTypeValues[:6])
+}
+
+func (s SensorType) MarshalText() ([]byte, error) {
+	if !s.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid SensorType %d", s)
+	}
+	return []byte(s.String()), nil
+}
+
+func (s *SensorType) UnmarshalText(text []byte) error {
+	value, err := ParseSensorType(string(text))
+	if err != nil {
+		return err
+	}
+	*s = value
+	return nil
+}
+
 type Shader struct {
 	ptr *C.sfShader
 }
@@ -2069,7 +2949,7 @@ func NewTextEventCArrayFromGoSlice(slice []TextEvent) *C.sfTextEvent {
 	return (*C.sfTextEvent)(ptr)
 }
 
-type TextStyle int32
+type TextStyle uint32
 
 const (
 	TextRegular TextStyle = C.sfTextRegular
@@ -2079,6 +2959,54 @@ const (
 	TextStrikeThrough TextStyle = C.sfTextStrikeThrough
 )
 
+var textStyleValues = []TextStyle{TextRegular, TextBold, TextItalic, TextUnderlined, TextStrikeThrough}
+
+var textStyleNames = []string{"TextRegular", "TextBold", "TextItalic", "TextUnderlined", "TextStrikeThrough"}
+
+func (t TextStyle) Has(flag TextStyle) bool {
+	return t&flag == flag
+}
+
+func (t TextStyle) With(flag TextStyle) TextStyle {
+	return t | flag
+}
+
+func (t TextStyle) Without(flag TextStyle) TextStyle {
+	return t &^ flag
+}
+
+func (t TextStyle) String() string {
+	return flagsString(t, textStyleValues, textStyleNames)
+}
+
+func ParseTextStyle(s string) (TextStyle, error) {
+	return parseFlags(s, textStyleValues, textStyleNames, "TextStyle")
+}
+
+func TextStyleValues() []TextStyle {
+	return append([]TextStyle(nil), textStyleValues[:5]...)
+}
+
+func (t TextStyle) IsValid() bool {
+	return flagsIsValid(t, textStyleValues[:5])
+}
+
+func (t TextStyle) MarshalText() ([]byte, error) {
+	if !t.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid TextStyle %d", t)
+	}
+	return []byte(t.String()), nil
+}
+
+func (t *TextStyle) UnmarshalText(text []byte) error {
+	value, err := ParseTextStyle(string(text))
+	if err != nil {
+		return err
+	}
+	*t = value
+	return nil
+}
+
 type Texture struct {
 	ptr *C.sfTexture
 }
@@ -2098,6 +3026,42 @@ const (
 	TexturePixels TextureCoordinateType = C.sfTexturePixels
 )
 
+var textureCoordinateTypeValues = []TextureCoordinateType{TextureNormalized, TexturePixels}
+
+var textureCoordinateTypeNames = []string{"TextureNormalized", "TexturePixels"}
+
+func (t TextureCoordinateType) String() string {
+	return enumString(t, textureCoordinateTypeValues, textureCoordinateTypeNames, "TextureCoordinateType")
+}
+
+func ParseTextureCoordinateType(s string) (TextureCoordinateType, error) {
+	return parseEnum(s, textureCoordinateTypeValues, textureCoordinateTypeNames, "TextureCoordinateType")
+}
+
+func TextureCoordinateTypeValues() []TextureCoordinateType {
+	return append([]TextureCoordinateType(nil), textureCoordinateTypeValues[:2]...)
+}
+
+func (t TextureCoordinateType) IsValid() bool {
+	return enumIsValid(t, textureCoordinateTypeValues[:2])
+}
+
+func (t TextureCoordinateType) MarshalText() ([]byte, error) {
+	if !t.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid TextureCoordinateType %d", t)
+	}
+	return []byte(t.String()), nil
+}
+
+func (t *TextureCoordinateType) UnmarshalText(text []byte) error {
+	value, err := ParseTextureCoordinateType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = value
+	return nil
+}
+
 type Time struct {
 	Microseconds int64
 }
@@ -2474,6 +3438,42 @@ const (
 	VertexBufferStatic VertexBufferUsage = C.sfVertexBufferStatic
 )
 
+var vertexBufferUsageValues = []VertexBufferUsage{VertexBufferStream, VertexBufferDynamic, VertexBufferStatic}
+
+var vertexBufferUsageNames = []string{"VertexBufferStream", "VertexBufferDynamic", "VertexBufferStatic"}
+
+func (v VertexBufferUsage) String() string {
+	return enumString(v, vertexBufferUsageValues, vertexBufferUsageNames, "VertexBufferUsage")
+}
+
+func ParseVertexBufferUsage(s string) (VertexBufferUsage, error) {
+	return parseEnum(s, vertexBufferUsageValues, vertexBufferUsageNames, "VertexBufferUsage")
+}
+
+func VertexBufferUsageValues() []VertexBufferUsage {
+	return append([]VertexBufferUsage(nil), vertexBufferUsageValues[:3]...)
+}
+
+func (v VertexBufferUsage) IsValid() bool {
+	return enumIsValid(v, vertexBufferUsageValues[:3])
+}
+
+func (v VertexBufferUsage) MarshalText() ([]byte, error) {
+	if !v.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid VertexBufferUsage %d", v)
+	}
+	return []byte(v.String()), nil
+}
+
+func (v *VertexBufferUsage) UnmarshalText(text []byte) error {
+	value, err := ParseVertexBufferUsage(string(text))
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
+
 type VideoMode struct {
 	Width uint32
 	Height uint32
@@ -2556,7 +3556,7 @@ func NewWindowBaseFromC(cPtr *C.sfWindowBase) *WindowBase {
 	return &WindowBase{ptr: cPtr}
 }
 
-type WindowStyle int32
+type WindowStyle uint32
 
 const (
 	None WindowStyle = C.sfNone
@@ -2567,6 +3567,54 @@ const (
 	DefaultStyle WindowStyle = C.sfDefaultStyle
 )
 
+var windowStyleValues = []WindowStyle{None, Titlebar, Resize, Close, Fullscreen, DefaultStyle}
+
+var windowStyleNames = []string{"None", "Titlebar", "Resize", "Close", "Fullscreen", "DefaultStyle"}
+
+func (w WindowStyle) Has(flag WindowStyle) bool {
+	return w&flag == flag
+}
+
+func (w WindowStyle) With(flag WindowStyle) WindowStyle {
+	return w | flag
+}
+
+func (w WindowStyle) Without(flag WindowStyle) WindowStyle {
+	return w &^ flag
+}
+
+func (w WindowStyle) String() string {
+	return flagsString(w, windowStyleValues, windowStyleNames)
+}
+
+func ParseWindowStyle(s string) (WindowStyle, error) {
+	return parseFlags(s, windowStyleValues, windowStyleNames, "WindowStyle")
+}
+
+func WindowStyleValues() []WindowStyle {
+	return append([]WindowStyle(nil), windowStyleValues[:6]...)
+}
+
+func (w WindowStyle) IsValid() bool {
+	return flagsIsValid(w, windowStyleValues[:6])
+}
+
+func (w WindowStyle) MarshalText() ([]byte, error) {
+	if !w.IsValid() {
+		return nil, fmt.Errorf("sfml: invalid WindowStyle %d", w)
+	}
+	return []byte(w.String()), nil
+}
+
+func (w *WindowStyle) UnmarshalText(text []byte) error {
+	value, err := ParseWindowStyle(string(text))
+	if err != nil {
+		return err
+	}
+	*w = value
+	return nil
+}
+
 type ClosedEvent struct {
 	BaseEvent
 	Type EventType
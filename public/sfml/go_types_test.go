@@ -0,0 +1,213 @@
+package sfml
+
+import (
+	"testing"
+	"unsafe"
+)
+
+// The buffers of stringToUTF32 are leaked, as test files can't call C.free.
+func TestUTF32RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []uint32
+		out  string
+	}{
+		{name: "empty", in: "", want: []uint32{}, out: ""},
+		{name: "ASCII", in: "SFML", want: []uint32{'S', 'F', 'M', 'L'}, out: "SFML"},
+		{name: "BMP", in: "héllo", want: []uint32{'h', 0xE9, 'l', 'l', 'o'}, out: "héllo"},
+		{name: "non-BMP", in: "a🎮𝄞", want: []uint32{'a', 0x1F3AE, 0x1D11E}, out: "a🎮𝄞"},
+		{name: "invalid UTF-8", in: "a\xffb", want: []uint32{'a', 0xFFFD, 'b'}, out: "a�b"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ptr := stringToUTF32(test.in)
+			buf := unsafe.Slice((*uint32)(ptr), len(test.want)+1)
+			for i, want := range test.want {
+				if buf[i] != want {
+					t.Errorf("code point %d = %#x, want %#x", i, buf[i], want)
+				}
+			}
+			if buf[len(test.want)] != 0 {
+				t.Errorf("missing terminator, got %#x", buf[len(test.want)])
+			}
+			if out := utf32ToString(ptr); out != test.out {
+				t.Errorf("utf32ToString = %q, want %q", out, test.out)
+			}
+		})
+	}
+}
+
+func TestUTF32ToStringInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []uint32
+		want string
+	}{
+		{name: "surrogate", in: []uint32{'a', 0xD800, 'b', 0}, want: "a�b"},
+		{name: "past the last code point", in: []uint32{0x110000, 'x', 0}, want: "�x"},
+		{name: "stops at the terminator", in: []uint32{'a', 0, 'b', 0}, want: "a"},
+	}
+	for _, test := range tests {
+		if got := utf32ToString(unsafe.Pointer(&test.in[0])); got != test.want {
+			t.Errorf("%s: utf32ToString = %q, want %q", test.name, got, test.want)
+		}
+	}
+	if got := utf32ToString(nil); got != "" {
+		t.Errorf("utf32ToString(nil) = %q, want empty", got)
+	}
+}
+
+func TestEnumText(t *testing.T) {
+	if got := KeyA.String(); got != "KeyA" {
+		t.Errorf("KeyA.String() = %q", got)
+	}
+	// Aliases share a value, so they print as the current name
+	if got := KeyTilde.String(); got != "KeyGrave" {
+		t.Errorf("KeyTilde.String() = %q, want KeyGrave", got)
+	}
+	if got := KeyCode(999).String(); got != "KeyCode(999)" {
+		t.Errorf("KeyCode(999).String() = %q", got)
+	}
+
+	tests := []struct {
+		in      string
+		want    KeyCode
+		wantErr bool
+	}{
+		{in: "KeySpace", want: KeySpace},
+		{in: "KeyTilde", want: KeyGrave},
+		{in: "KeyCount", wantErr: true},
+		{in: "keyspace", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseKeyCode(test.in)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("ParseKeyCode(%q) = %v, %v, want %v, error %t", test.in, got, err, test.want, test.wantErr)
+		}
+	}
+
+	for _, key := range KeyCodeValues() {
+		if !key.IsValid() {
+			t.Errorf("%v is not valid", key)
+		}
+		text, err := key.MarshalText()
+		if err != nil {
+			t.Fatalf("%v.MarshalText() failed: %v", key, err)
+		}
+		var back KeyCode
+		if err := back.UnmarshalText(text); err != nil || back != key {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, back, err, key)
+		}
+	}
+	if len(KeyCodeValues()) != int(KeyCount) {
+		t.Errorf("KeyCodeValues has %d values, want %d", len(KeyCodeValues()), KeyCount)
+	}
+	for _, key := range []KeyCode{KeyCount, KeyCode(-2), KeyCode(999)} {
+		if key.IsValid() {
+			t.Errorf("%d is valid", key)
+		}
+		if _, err := key.MarshalText(); err == nil {
+			t.Errorf("%d.MarshalText() succeeded", key)
+		}
+	}
+	var key KeyCode
+	if err := key.UnmarshalText([]byte("KeyCount")); err == nil {
+		t.Errorf("UnmarshalText(KeyCount) succeeded")
+	}
+}
+
+func TestFlagsText(t *testing.T) {
+	tests := []struct {
+		style WindowStyle
+		want  string
+	}{
+		{style: None, want: "None"},
+		{style: Titlebar, want: "Titlebar"},
+		{style: DefaultStyle, want: "Titlebar|Resize|Close"},
+		{style: Titlebar | Fullscreen, want: "Titlebar|Fullscreen"},
+		{style: Close | 0x40, want: "Close|0x40"},
+		{style: 0x30, want: "0x30"},
+	}
+	for _, test := range tests {
+		if got := test.style.String(); got != test.want {
+			t.Errorf("WindowStyle(%#x).String() = %q, want %q", uint32(test.style), got, test.want)
+		}
+	}
+	if got := (TextBold | TextItalic).String(); got != "TextBold|TextItalic" {
+		t.Errorf("TextStyle String() = %q", got)
+	}
+	if got := TextRegular.String(); got != "TextRegular" {
+		t.Errorf("TextRegular.String() = %q", got)
+	}
+
+	parses := []struct {
+		in      string
+		want    WindowStyle
+		wantErr bool
+	}{
+		{in: "None", want: None},
+		{in: "DefaultStyle", want: DefaultStyle},
+		{in: "Titlebar | Close", want: Titlebar | Close},
+		{in: "Resize|0x10", want: Resize | 0x10},
+		{in: "Titlebar|Bogus", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, test := range parses {
+		got, err := ParseWindowStyle(test.in)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("ParseWindowStyle(%q) = %v, %v, want %v, error %t", test.in, got, err, test.want, test.wantErr)
+		}
+	}
+
+	if !DefaultStyle.IsValid() || !None.IsValid() || (Titlebar | 0x10).IsValid() {
+		t.Errorf("IsValid is wrong for DefaultStyle, None or an unknown bit")
+	}
+	if _, err := WindowStyle(0x10).MarshalText(); err == nil {
+		t.Errorf("MarshalText of an unknown bit succeeded")
+	}
+	text, err := (Titlebar | Fullscreen).MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var style WindowStyle
+	if err := style.UnmarshalText(text); err != nil || style != Titlebar|Fullscreen {
+		t.Errorf("UnmarshalText(%q) = %v, %v", text, style, err)
+	}
+}
+
+func TestFlagsSet(t *testing.T) {
+	style := DefaultStyle
+	if !style.Has(Titlebar) || !style.Has(Titlebar|Close) || style.Has(Fullscreen) || !style.Has(None) {
+		t.Errorf("Has is wrong for %v", style)
+	}
+	if got := style.Without(Resize); got != Titlebar|Close {
+		t.Errorf("Without(Resize) = %v", got)
+	}
+	if got := style.With(Fullscreen); got != DefaultStyle|Fullscreen {
+		t.Errorf("With(Fullscreen) = %v", got)
+	}
+}
+
+func TestEventDispatcherZeroValue(t *testing.T) {
+	var dispatcher EventDispatcher
+	if dispatcher.Dispatch(&ClosedEvent{Type: EvtClosed}) {
+		t.Error("Dispatch without handlers reported the event as consumed")
+	}
+
+	var codes []KeyCode
+	handler := dispatcher.OnKeyPressed(func(event *KeyEvent) {
+		codes = append(codes, event.Code)
+	})
+	dispatcher.OnKeyPressed(func(*KeyEvent) {}).Consume(true)
+
+	if !dispatcher.Dispatch(&KeyEvent{Type: EvtKeyPressed, Code: KeyA}) {
+		t.Error("Dispatch did not report the event as consumed")
+	}
+	handler.Unsubscribe()
+	dispatcher.Dispatch(&KeyEvent{Type: EvtKeyPressed, Code: KeyB})
+	if len(codes) != 1 || codes[0] != KeyA {
+		t.Errorf("handler saw %v, want [KeyA]", codes)
+	}
+}
@@ -0,0 +1,285 @@
+package sfml
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HotReloader reloads shaders, textures and fonts when their files change on
+// disk, for use during development. A goroutine polls the files' modification
+// times, and Update, called once per frame on the render thread, reloads what
+// changed and swaps it into the existing handles, so code holding them keeps
+// working.
+//
+// A texture is swapped in place, so sprites using it show the new version. A
+// shader or font gets a new C object: RenderStates copies the shader handle, so
+// build it from the handle after Update, and texts must SetFont again to show a
+// reloaded font. Replaced fonts are kept alive until Close, as texts may still
+// use them.
+type HotReloader struct {
+	mu      sync.Mutex
+	entries []*hotReloadEntry
+	retired []*Font
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+type hotReloadEntry struct {
+	handle  any
+	fsys    fs.FS // nil for files on disk
+	files   map[string]fileStamp
+	pending bool
+	// reload creates the new version and swaps it into handle. It returns the
+	// files to watch from now on, or nil to keep the current ones.
+	reload func() ([]string, error)
+}
+
+// fileStamp identifies a version of a file.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewHotReloader creates a reloader that checks the watched files every
+// interval.
+func NewHotReloader(interval time.Duration) *HotReloader {
+	r := &HotReloader{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go r.poll(interval)
+	return r
+}
+
+// Close stops polling and frees the fonts replaced by reloads. The watched
+// handles are not freed.
+func (r *HotReloader) Close() {
+	close(r.stop)
+	<-r.done
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, font := range r.retired {
+		font.Free()
+	}
+	r.retired = nil
+	r.entries = nil
+}
+
+// WatchShader reloads shader from its files when one of them changes, like
+// NewShaderFromFile. Pass the filenames it was loaded with. If compiling fails,
+// the compile log is in the error Update returns.
+func (r *HotReloader) WatchShader(shader *Shader, vertexShaderFilename, geometryShaderFilename, fragmentShaderFilename *string) {
+	var files []string
+	for _, filename := range []*string{vertexShaderFilename, geometryShaderFilename, fragmentShaderFilename} {
+		if filename != nil {
+			files = append(files, *filename)
+		}
+	}
+	r.watch(shader, nil, files, func() ([]string, error) {
+		var sources [3]string
+		for i, filename := range []*string{vertexShaderFilename, geometryShaderFilename, fragmentShaderFilename} {
+			if filename == nil {
+				continue
+			}
+			data, err := os.ReadFile(*filename)
+			if err != nil {
+				return nil, fmt.Errorf("sfml: failed to reload shader: %w", err)
+			}
+			sources[i] = string(data)
+		}
+		reloaded, log := compileShader(sources[0], sources[1], sources[2])
+		if reloaded.ToC() == nil {
+			if log = strings.TrimSpace(log); log != "" {
+				return nil, fmt.Errorf("sfml: failed to reload shader %v:\n%s", files, log)
+			}
+			return nil, fmt.Errorf("sfml: failed to reload shader %v", files)
+		}
+		shader.swap(reloaded)
+		return nil, nil
+	})
+}
+
+// WatchPreprocessedShader reloads shader with preprocessor when one of its
+// files, includes included, changes. Pass the names it was loaded with, see
+// ShaderPreprocessor.NewShader.
+func (r *HotReloader) WatchPreprocessedShader(shader *Shader, preprocessor *ShaderPreprocessor, vertexShader, geometryShader, fragmentShader string) error {
+	sources, err := preprocessor.PreprocessShader(vertexShader, geometryShader, fragmentShader)
+	if err != nil {
+		return err
+	}
+	r.watch(shader, preprocessor.FS, sources.Files(), func() ([]string, error) {
+		sources, err := preprocessor.PreprocessShader(vertexShader, geometryShader, fragmentShader)
+		if err != nil {
+			return nil, err
+		}
+		reloaded, err := sources.NewShader()
+		if err != nil {
+			return sources.Files(), err
+		}
+		shader.swap(reloaded)
+		return sources.Files(), nil
+	})
+	return nil
+}
+
+// WatchTexture reloads texture with NewTextureFromFile when its file changes,
+// keeping its smooth, repeated and sRGB settings. Pass the filename and area it
+// was loaded with.
+func (r *HotReloader) WatchTexture(texture *Texture, filename string, area *IntRect) {
+	r.watch(texture, nil, []string{filename}, func() ([]string, error) {
+		var reloaded *Texture
+		if texture.IsSrgb() {
+			reloaded = NewTextureSrgbFromFile(filename, area)
+		} else {
+			reloaded = NewTextureFromFile(filename, area)
+		}
+		if reloaded.ToC() == nil {
+			return nil, fmt.Errorf("sfml: failed to reload texture %s", filename)
+		}
+		reloaded.SetSmooth(texture.IsSmooth())
+		reloaded.SetRepeated(texture.IsRepeated())
+		texture.Swap(reloaded)
+		reloaded.Free()
+		return nil, nil
+	})
+}
+
+// WatchFont reloads font with NewFontFromFile when its file changes. Pass the
+// filename it was loaded with.
+func (r *HotReloader) WatchFont(font *Font, filename string) {
+	r.watch(font, nil, []string{filename}, func() ([]string, error) {
+		reloaded := NewFontFromFile(filename)
+		if reloaded.ToC() == nil {
+			return nil, fmt.Errorf("sfml: failed to reload font %s", filename)
+		}
+		r.mu.Lock()
+		r.retired = append(r.retired, &Font{ptr: font.ptr})
+		r.mu.Unlock()
+		font.ptr = reloaded.ptr
+		return nil, nil
+	})
+}
+
+// Unwatch stops watching a handle passed to one of the Watch methods.
+func (r *HotReloader) Unwatch(handle any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, entry := range r.entries {
+		if entry.handle == handle {
+			r.entries = append(r.entries[:i], r.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// Update reloads the handles whose files changed. It must be called on the
+// render thread, between frames. A handle that fails to reload keeps its current
+// version, and the failures are returned joined.
+func (r *HotReloader) Update() error {
+	assertMainThread()
+	r.mu.Lock()
+	var pending []*hotReloadEntry
+	for _, entry := range r.entries {
+		if entry.pending {
+			entry.pending = false
+			pending = append(pending, entry)
+		}
+	}
+	r.mu.Unlock()
+
+	var errs []error
+	for _, entry := range pending {
+		files, err := entry.reload()
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if files != nil {
+			stamps := stampFiles(entry.fsys, files)
+			r.mu.Lock()
+			entry.files = stamps
+			r.mu.Unlock()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *HotReloader) watch(handle any, fsys fs.FS, files []string, reload func() ([]string, error)) {
+	entry := &hotReloadEntry{
+		handle: handle,
+		fsys:   fsys,
+		files:  stampFiles(fsys, files),
+		reload: reload,
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, entry)
+}
+
+// poll checks the files every interval until Close is called.
+func (r *HotReloader) poll(interval time.Duration) {
+	defer close(r.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.check()
+		}
+	}
+}
+
+// check marks the entries whose files changed since they were last stamped.
+func (r *HotReloader) check() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, entry := range r.entries {
+		for name, stamp := range entry.files {
+			current, ok := stampFile(entry.fsys, name)
+			// A file that is missing, e.g. while an editor replaces it, is checked
+			// again on the next tick
+			if ok && current != stamp {
+				entry.files[name] = current
+				entry.pending = true
+			}
+		}
+	}
+}
+
+func stampFiles(fsys fs.FS, files []string) map[string]fileStamp {
+	stamps := make(map[string]fileStamp, len(files))
+	for _, name := range files {
+		stamps[name], _ = stampFile(fsys, name)
+	}
+	return stamps
+}
+
+func stampFile(fsys fs.FS, name string) (fileStamp, bool) {
+	var info fs.FileInfo
+	var err error
+	if fsys == nil {
+		info, err = os.Stat(name)
+	} else {
+		info, err = fs.Stat(fsys, name)
+	}
+	if err != nil {
+		return fileStamp{}, false
+	}
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}, true
+}
+
+// swap replaces the C shader of s with the one of other and frees the old one.
+func (s *Shader) swap(other *Shader) {
+	old := &Shader{ptr: s.ptr}
+	s.ptr = other.ptr
+	other.ptr = nil
+	old.Free()
+}
@@ -0,0 +1,226 @@
+package sfml
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+var reloadEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeReload counts the reloads of an entry and fails with err when it is set.
+type fakeReload struct {
+	calls int
+	files []string
+	err   error
+}
+
+func (f *fakeReload) reload() ([]string, error) {
+	f.calls++
+	return f.files, f.err
+}
+
+func TestStampFile(t *testing.T) {
+	fsys := fstest.MapFS{"a.frag": {Data: []byte("abc"), ModTime: reloadEpoch}}
+	stamp, ok := stampFile(fsys, "a.frag")
+	if !ok || stamp != (fileStamp{modTime: reloadEpoch, size: 3}) {
+		t.Errorf("stampFile = %+v, %t", stamp, ok)
+	}
+	if _, ok := stampFile(fsys, "missing.frag"); ok {
+		t.Error("stampFile of a missing file is ok")
+	}
+
+	// A nil FS means the disk
+	name := filepath.Join(t.TempDir(), "b.frag")
+	if err := os.WriteFile(name, []byte("abcd"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(name, reloadEpoch, reloadEpoch); err != nil {
+		t.Fatal(err)
+	}
+	stamp, ok = stampFile(nil, name)
+	if !ok || !stamp.modTime.Equal(reloadEpoch) || stamp.size != 4 {
+		t.Errorf("stampFile on disk = %+v, %t", stamp, ok)
+	}
+
+	stamps := stampFiles(fsys, []string{"a.frag", "missing.frag"})
+	if len(stamps) != 2 || stamps["missing.frag"] != (fileStamp{}) {
+		t.Errorf("stampFiles = %+v", stamps)
+	}
+}
+
+func TestHotReloaderCheck(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.frag": {Data: []byte("a"), ModTime: reloadEpoch},
+		"b.frag": {Data: []byte("b"), ModTime: reloadEpoch},
+	}
+	var r HotReloader
+	a, b := &fakeReload{}, &fakeReload{}
+	r.watch(a, fsys, []string{"a.frag"}, a.reload)
+	r.watch(b, fsys, []string{"b.frag"}, b.reload)
+
+	r.check()
+	if err := Call(r.Update); err != nil || a.calls != 0 || b.calls != 0 {
+		t.Fatalf("Update without changes = %v, reloads %d, %d", err, a.calls, b.calls)
+	}
+
+	// A newer time or another size is a change
+	fsys["a.frag"].ModTime = reloadEpoch.Add(time.Second)
+	fsys["b.frag"].Data = []byte("bb")
+	r.check()
+	r.check()
+	if err := Call(r.Update); err != nil || a.calls != 1 || b.calls != 1 {
+		t.Fatalf("Update after changes = %v, reloads %d, %d, want 1, 1", err, a.calls, b.calls)
+	}
+	if err := Call(r.Update); err != nil || a.calls != 1 || b.calls != 1 {
+		t.Fatalf("second Update = %v, reloads %d, %d, want 1, 1", err, a.calls, b.calls)
+	}
+
+	// A file being replaced is missing for a moment, which is not a change
+	saved := fsys["a.frag"]
+	delete(fsys, "a.frag")
+	r.check()
+	fsys["a.frag"] = saved
+	r.check()
+	if Call(r.Update); a.calls != 1 {
+		t.Errorf("reloaded %d times after the file came back unchanged, want 1", a.calls)
+	}
+	fsys["a.frag"] = &fstest.MapFile{Data: []byte("a"), ModTime: reloadEpoch.Add(2 * time.Second)}
+	r.check()
+	if Call(r.Update); a.calls != 2 {
+		t.Errorf("reloaded %d times after the file was replaced, want 2", a.calls)
+	}
+}
+
+func TestHotReloaderUpdateErrors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.frag":   {Data: []byte("a"), ModTime: reloadEpoch},
+		"b.frag":   {Data: []byte("b"), ModTime: reloadEpoch},
+		"lib.glsl": {Data: []byte("lib"), ModTime: reloadEpoch},
+	}
+	var r HotReloader
+	errA, errB := errors.New("a failed"), errors.New("b failed")
+	a := &fakeReload{err: errA}
+	// b now includes lib.glsl, so it is watched even though reloading failed
+	b := &fakeReload{err: errB, files: []string{"b.frag", "lib.glsl"}}
+	ok := &fakeReload{}
+	r.watch(a, fsys, []string{"a.frag"}, a.reload)
+	r.watch(b, fsys, []string{"b.frag"}, b.reload)
+	r.watch(ok, fsys, []string{"a.frag"}, ok.reload)
+
+	fsys["a.frag"].ModTime = reloadEpoch.Add(time.Second)
+	fsys["b.frag"].ModTime = reloadEpoch.Add(time.Second)
+	r.check()
+	err := Call(r.Update)
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Errorf("Update = %v, want both failures joined", err)
+	}
+	if ok.calls != 1 {
+		t.Errorf("the entry that didn't fail reloaded %d times, want 1", ok.calls)
+	}
+
+	// Failed entries are reloaded again on their next change
+	fsys["lib.glsl"].ModTime = reloadEpoch.Add(time.Second)
+	r.check()
+	if err := Call(r.Update); !errors.Is(err, errB) || errors.Is(err, errA) {
+		t.Errorf("Update after an include changed = %v, want only %v", err, errB)
+	}
+	if a.calls != 1 || b.calls != 2 {
+		t.Errorf("reloads = %d, %d, want 1, 2", a.calls, b.calls)
+	}
+}
+
+func TestHotReloaderUnwatch(t *testing.T) {
+	fsys := fstest.MapFS{"a.frag": {Data: []byte("a"), ModTime: reloadEpoch}}
+	var r HotReloader
+	a, b := &fakeReload{}, &fakeReload{}
+	r.watch(a, fsys, []string{"a.frag"}, a.reload)
+	r.watch(b, fsys, []string{"a.frag"}, b.reload)
+
+	r.Unwatch(a)
+	r.Unwatch(&fakeReload{})
+	if len(r.entries) != 1 || r.entries[0].handle != b {
+		t.Fatalf("entries after Unwatch = %+v", r.entries)
+	}
+
+	// A pending reload is dropped with its entry
+	fsys["a.frag"].ModTime = reloadEpoch.Add(time.Second)
+	r.check()
+	r.Unwatch(b)
+	if err := Call(r.Update); err != nil || a.calls != 0 || b.calls != 0 {
+		t.Errorf("Update after Unwatch = %v, reloads %d, %d", err, a.calls, b.calls)
+	}
+}
+
+func TestHotReloaderPoll(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "a.frag")
+	if err := os.WriteFile(name, []byte("a"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(name, reloadEpoch, reloadEpoch); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewHotReloader(time.Millisecond)
+	defer r.Close()
+	a := &fakeReload{}
+	r.watch(a, nil, []string{name}, a.reload)
+
+	if err := os.Chtimes(name, reloadEpoch, reloadEpoch.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for a.calls == 0 && time.Now().Before(deadline) {
+		if err := Call(r.Update); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if a.calls != 1 {
+		t.Errorf("reloaded %d times after a change, want 1", a.calls)
+	}
+}
+
+func TestWatchShaderError(t *testing.T) {
+	dir := t.TempDir()
+	fragment := filepath.Join(dir, "broken.frag")
+	if err := os.WriteFile(fragment, []byte("void main() { broken }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(fragment, reloadEpoch, reloadEpoch); err != nil {
+		t.Fatal(err)
+	}
+
+	var r HotReloader
+	shader := &Shader{}
+	r.WatchShader(shader, nil, nil, &fragment)
+	if err := os.Chtimes(fragment, reloadEpoch, reloadEpoch.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	r.check()
+	err := Call(r.Update)
+	if err == nil {
+		// Compiled, so there is an OpenGL context and nothing to check
+		Do(shader.Free)
+		t.Skip("the broken shader compiled")
+	}
+	if !strings.HasPrefix(err.Error(), "sfml: failed to reload shader ["+fragment+"]") {
+		t.Errorf("Update = %v", err)
+	}
+	if shader.ToC() != nil {
+		t.Error("failed reload replaced the shader")
+	}
+
+	// Files that can't be read are reported too
+	if err := os.Remove(fragment); err != nil {
+		t.Fatal(err)
+	}
+	r.entries[0].pending = true
+	if err := Call(r.Update); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Update with a missing file = %v, want os.ErrNotExist", err)
+	}
+}
@@ -0,0 +1,121 @@
+package sfml
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"unsafe"
+)
+
+// SFML stores image pixels as tightly packed, non-premultiplied RGBA, which is
+// the layout of image.NRGBA. The helpers below use that to move pixels between
+// SFML and the standard image packages without going through Pixel/SetPixel.
+//
+// Image implements draw.Image, but each At and Set call goes through cgo. For
+// bulk work such as draw.Draw or png.Encode, pass View instead, which the
+// standard packages handle without per-pixel calls.
+
+var _ draw.Image = (*Image)(nil)
+
+// ColorModel implements image.Image.
+func (i *Image) ColorModel() color.Model {
+	return color.NRGBAModel
+}
+
+// Bounds implements image.Image. The bounds always start at the origin.
+func (i *Image) Bounds() image.Rectangle {
+	size := i.Size()
+	return image.Rect(0, 0, int(size.X), int(size.Y))
+}
+
+// At implements image.Image by reading the C pixel buffer directly.
+func (i *Image) At(x, y int) color.Color {
+	img := i.View()
+	if img == nil {
+		return color.NRGBA{}
+	}
+	return img.NRGBAAt(x, y)
+}
+
+// Set implements draw.Image by writing the C pixel buffer directly. Points
+// outside the bounds are ignored.
+func (i *Image) Set(x, y int, c color.Color) {
+	img := i.View()
+	if img == nil {
+		return
+	}
+	img.Set(x, y, c)
+}
+
+// ToRGBA copies the image into a new, premultiplied image.RGBA.
+func (i *Image) ToRGBA() *image.RGBA {
+	bounds := i.Bounds()
+	dst := image.NewRGBA(bounds)
+	if src := i.View(); src != nil {
+		draw.Draw(dst, bounds, src, image.Point{}, draw.Src)
+	}
+	return dst
+}
+
+// NewImageFromGoImage creates an image with a copy of the pixels of img. The
+// result always starts at the origin, whatever img.Bounds().Min is.
+func NewImageFromGoImage(img image.Image) *Image {
+	src := toNRGBA(img)
+	width, height := src.Rect.Dx(), src.Rect.Dy()
+	if width == 0 || height == 0 {
+		return NewImage(int32(width), int32(height))
+	}
+	return NewImageFromPixels(int32(width), int32(height), &src.Pix[0])
+}
+
+// UpdateFromGoImage copies the pixels of img into the texture, with the top-left
+// corner of img placed at (x, y). It returns an error, and changes nothing, if
+// img doesn't fit inside the texture at that position.
+func (t *Texture) UpdateFromGoImage(img image.Image, x int32, y int32) error {
+	size := t.Size()
+	bounds := img.Bounds()
+	if err := checkUpdateBounds(bounds.Dx(), bounds.Dy(), int(x), int(y), int(size.X), int(size.Y)); err != nil {
+		return err
+	}
+	if bounds.Empty() {
+		return nil
+	}
+	src := toNRGBA(img)
+	t.UpdateFromPixels(&src.Pix[0], int32(src.Rect.Dx()), int32(src.Rect.Dy()), x, y)
+	return nil
+}
+
+// checkUpdateBounds checks that a width by height area placed at (x, y) lies
+// inside a destination of the given size.
+func checkUpdateBounds(width, height, x, y, destWidth, destHeight int) error {
+	if x < 0 || y < 0 || x+width > destWidth || y+height > destHeight {
+		return fmt.Errorf("sfml: %dx%d pixels at (%d, %d) don't fit in %dx%d", width, height, x, y, destWidth, destHeight)
+	}
+	return nil
+}
+
+// View returns an image.NRGBA that aliases the C pixel buffer, or nil if the
+// image is empty. Writes to the view modify the image. It must not outlive the
+// next call that resizes or frees i.
+func (i *Image) View() *image.NRGBA {
+	bounds := i.Bounds()
+	if bounds.Empty() {
+		return nil
+	}
+	pix := unsafe.Slice(i.PixelsPtr(), 4*bounds.Dx()*bounds.Dy())
+	return &image.NRGBA{Pix: pix, Stride: 4 * bounds.Dx(), Rect: bounds}
+}
+
+// toNRGBA returns img as a tightly packed image.NRGBA starting at the origin,
+// copying only when img is not already in that form.
+func toNRGBA(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	if src, ok := img.(*image.NRGBA); ok && bounds.Min == (image.Point{}) && src.Stride == 4*bounds.Dx() {
+		return src
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Rect, img, bounds.Min, draw.Src)
+	return dst
+}
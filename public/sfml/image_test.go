@@ -0,0 +1,134 @@
+package sfml
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"testing"
+)
+
+// testPattern returns a w by h image with a distinct color in every pixel.
+func testPattern(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 40), G: uint8(y * 40), B: uint8(x + y), A: uint8(255 - x*y)})
+		}
+	}
+	return img
+}
+
+func TestImageRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		src  image.Image
+	}{
+		{name: "NRGBA", src: testPattern(4, 3)},
+		{name: "offset NRGBA", src: testPattern(6, 5).SubImage(image.Rect(1, 2, 5, 5))},
+		{name: "RGBA", src: func() image.Image {
+			img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+			draw.Draw(img, img.Rect, &image.Uniform{C: color.NRGBA{R: 200, G: 100, B: 50, A: 255}}, image.Point{}, draw.Src)
+			return img
+		}()},
+		{name: "Gray", src: image.NewGray(image.Rect(0, 0, 2, 2))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := NewImageFromGoImage(tt.src)
+			defer img.Free()
+
+			bounds := tt.src.Bounds()
+			if got, want := img.Bounds(), image.Rect(0, 0, bounds.Dx(), bounds.Dy()); got != want {
+				t.Fatalf("Bounds() = %v, want %v", got, want)
+			}
+			for y := 0; y < bounds.Dy(); y++ {
+				for x := 0; x < bounds.Dx(); x++ {
+					want := color.NRGBAModel.Convert(tt.src.At(bounds.Min.X+x, bounds.Min.Y+y))
+					if got := img.At(x, y); got != want {
+						t.Errorf("At(%d, %d) = %v, want %v", x, y, got, want)
+					}
+					if got := img.View().NRGBAAt(x, y); got != want {
+						t.Errorf("View().NRGBAAt(%d, %d) = %v, want %v", x, y, got, want)
+					}
+				}
+			}
+
+			rgba := img.ToRGBA()
+			for y := 0; y < bounds.Dy(); y++ {
+				for x := 0; x < bounds.Dx(); x++ {
+					want := color.RGBAModel.Convert(tt.src.At(bounds.Min.X+x, bounds.Min.Y+y))
+					if got := rgba.RGBAAt(x, y); got != want {
+						t.Errorf("ToRGBA().RGBAAt(%d, %d) = %v, want %v", x, y, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestImageSetAndDraw(t *testing.T) {
+	img := NewImage(3, 3)
+	defer img.Free()
+
+	img.Set(1, 1, color.NRGBA{R: 1, G: 2, B: 3, A: 4})
+	img.Set(5, 5, color.Black) // out of bounds, ignored
+	if got, want := img.At(1, 1), (color.NRGBA{R: 1, G: 2, B: 3, A: 4}); got != want {
+		t.Errorf("At(1, 1) after Set = %v, want %v", got, want)
+	}
+
+	draw.Draw(img.View(), image.Rect(0, 0, 2, 1), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	if got := img.At(1, 0); got != (color.NRGBA{R: 255, G: 255, B: 255, A: 255}) {
+		t.Errorf("At(1, 0) after draw.Draw on View = %v, want white", got)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img.View()); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again := NewImageFromGoImage(decoded)
+	defer again.Free()
+	if !bytes.Equal(again.View().Pix, img.View().Pix) {
+		t.Errorf("PNG round trip changed the pixels")
+	}
+}
+
+func TestEmptyImage(t *testing.T) {
+	img := NewImageFromGoImage(image.NewNRGBA(image.Rect(0, 0, 0, 0)))
+	defer img.Free()
+	if !img.Bounds().Empty() || img.View() != nil {
+		t.Errorf("empty image has bounds %v and view %v", img.Bounds(), img.View())
+	}
+	if got := img.At(0, 0); got != (color.NRGBA{}) {
+		t.Errorf("At(0, 0) of empty image = %v, want transparent", got)
+	}
+}
+
+func TestCheckUpdateBounds(t *testing.T) {
+	tests := []struct {
+		name                                       string
+		width, height, x, y, destWidth, destHeight int
+		wantErr                                    bool
+	}{
+		{name: "fills", width: 4, height: 4, destWidth: 4, destHeight: 4},
+		{name: "inside", width: 2, height: 1, x: 1, y: 3, destWidth: 4, destHeight: 4},
+		{name: "empty at edge", x: 4, y: 4, destWidth: 4, destHeight: 4},
+		{name: "too wide", width: 5, height: 1, destWidth: 4, destHeight: 4, wantErr: true},
+		{name: "past bottom", width: 1, height: 2, y: 3, destWidth: 4, destHeight: 4, wantErr: true},
+		{name: "negative x", width: 1, height: 1, x: -1, destWidth: 4, destHeight: 4, wantErr: true},
+		{name: "negative y", width: 1, height: 1, y: -1, destWidth: 4, destHeight: 4, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkUpdateBounds(tt.width, tt.height, tt.x, tt.y, tt.destWidth, tt.destHeight)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkUpdateBounds() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package sfml
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Input tracks keyboard and mouse state from events, so queries need no cgo
+// call. Call Update once per frame before feeding it that frame's events:
+//
+//	input.Update()
+//	for event := range window.Events() {
+//		input.Handle(event)
+//	}
+//	if input.ActionJustPressed("jump") {
+//		...
+//	}
+//
+// States are per frame: a key is "just pressed" in the frame its press event
+// arrived, and "held" in the frames after that while it stays down.
+type Input struct {
+	keys      buttonStates[KeyCode]
+	scancodes buttonStates[Scancode]
+	buttons   buttonStates[MouseButton]
+
+	mouse      Vector2i
+	mouseDelta Vector2i
+	hasMouse   bool
+	wheel      [2]float32
+
+	actions Actions
+}
+
+// NewInput creates an input tracker with no actions bound.
+func NewInput() *Input {
+	return &Input{
+		keys:      newButtonStates[KeyCode](),
+		scancodes: newButtonStates[Scancode](),
+		buttons:   newButtonStates[MouseButton](),
+		actions:   Actions{},
+	}
+}
+
+// Update starts a new frame: it clears the just pressed and just released
+// states, the mouse delta and the wheel offsets.
+func (i *Input) Update() {
+	i.keys.endFrame()
+	i.scancodes.endFrame()
+	i.buttons.endFrame()
+	i.mouseDelta = Vector2i{}
+	i.wheel = [2]float32{}
+}
+
+// Handle updates the state from event. Events that don't concern the keyboard
+// or the mouse are ignored.
+func (i *Input) Handle(event Event) {
+	switch e := event.(type) {
+	case *KeyEvent:
+		switch e.Type {
+		case EvtKeyPressed:
+			i.keys.press(e.Code)
+			i.scancodes.press(e.Scancode)
+		case EvtKeyReleased:
+			i.keys.release(e.Code)
+			i.scancodes.release(e.Scancode)
+		}
+	case *MouseButtonEvent:
+		switch e.Type {
+		case EvtMouseButtonPressed:
+			i.buttons.press(e.Button)
+		case EvtMouseButtonReleased:
+			i.buttons.release(e.Button)
+		}
+		i.moveMouse(e.X, e.Y)
+	case *MouseMoveEvent:
+		i.moveMouse(e.X, e.Y)
+	case *MouseWheelScrollEvent:
+		// MouseWheelEvent is skipped: SFML sends it along with the vertical
+		// scroll event, so counting both would double the offset.
+		if e.Wheel == MouseVerticalWheel || e.Wheel == MouseHorizontalWheel {
+			i.wheel[e.Wheel] += e.Delta
+		}
+		i.moveMouse(e.X, e.Y)
+	case *LostFocusEvent:
+		// Releases are not reported to an unfocused window, so nothing can be
+		// assumed to still be down.
+		i.keys.releaseAll()
+		i.scancodes.releaseAll()
+		i.buttons.releaseAll()
+	}
+}
+
+// KeyPressed reports whether key is down.
+func (i *Input) KeyPressed(key KeyCode) bool { return i.keys.pressed(key) }
+
+// KeyJustPressed reports whether key went down this frame.
+func (i *Input) KeyJustPressed(key KeyCode) bool { return i.keys.justPressed(key) }
+
+// KeyJustReleased reports whether key went up this frame.
+func (i *Input) KeyJustReleased(key KeyCode) bool { return i.keys.justReleased(key) }
+
+// KeyHeld reports whether key has been down since before this frame.
+func (i *Input) KeyHeld(key KeyCode) bool { return i.keys.held(key) }
+
+// ScancodePressed reports whether code is down.
+func (i *Input) ScancodePressed(code Scancode) bool { return i.scancodes.pressed(code) }
+
+// ScancodeJustPressed reports whether code went down this frame.
+func (i *Input) ScancodeJustPressed(code Scancode) bool { return i.scancodes.justPressed(code) }
+
+// ScancodeJustReleased reports whether code went up this frame.
+func (i *Input) ScancodeJustReleased(code Scancode) bool { return i.scancodes.justReleased(code) }
+
+// ScancodeHeld reports whether code has been down since before this frame.
+func (i *Input) ScancodeHeld(code Scancode) bool { return i.scancodes.held(code) }
+
+// ButtonPressed reports whether button is down.
+func (i *Input) ButtonPressed(button MouseButton) bool { return i.buttons.pressed(button) }
+
+// ButtonJustPressed reports whether button went down this frame.
+func (i *Input) ButtonJustPressed(button MouseButton) bool { return i.buttons.justPressed(button) }
+
+// ButtonJustReleased reports whether button went up this frame.
+func (i *Input) ButtonJustReleased(button MouseButton) bool { return i.buttons.justReleased(button) }
+
+// ButtonHeld reports whether button has been down since before this frame.
+func (i *Input) ButtonHeld(button MouseButton) bool { return i.buttons.held(button) }
+
+// MousePosition returns the last known mouse position, relative to the window.
+func (i *Input) MousePosition() Vector2i {
+	return i.mouse
+}
+
+// MouseDelta returns how far the mouse moved this frame.
+func (i *Input) MouseDelta() Vector2i {
+	return i.mouseDelta
+}
+
+// Wheel returns how far wheel scrolled this frame.
+func (i *Input) Wheel(wheel MouseWheel) float32 {
+	if wheel != MouseVerticalWheel && wheel != MouseHorizontalWheel {
+		return 0
+	}
+	return i.wheel[wheel]
+}
+
+func (i *Input) moveMouse(x int32, y int32) {
+	if i.hasMouse {
+		i.mouseDelta.X += x - i.mouse.X
+		i.mouseDelta.Y += y - i.mouse.Y
+	}
+	i.mouse = Vector2i{X: x, Y: y}
+	i.hasMouse = true
+}
+
+// Binding is a combination of keys and mouse buttons that must all be down
+// together, such as Control+S.
+type Binding struct {
+	Keys    []KeyCode     `json:"keys,omitempty" yaml:"keys,omitempty"`
+	Buttons []MouseButton `json:"buttons,omitempty" yaml:"buttons,omitempty"`
+}
+
+// Actions maps action names to their bindings. An action is active when any of
+// its bindings is. Keys and buttons are written with the Go constant names, as
+// they implement encoding.TextUnmarshaler. In YAML:
+//
+//	jump:
+//	  - keys: [KeySpace]
+//	  - buttons: [MouseRight]
+//	dash:
+//	  - keys: [KeyD, KeySpace]
+//
+// or the same in JSON:
+//
+//	{
+//		"jump": [{"keys": ["KeySpace"]}, {"buttons": ["MouseRight"]}],
+//		"dash": [{"keys": ["KeyD", "KeySpace"]}]
+//	}
+type Actions map[string][]Binding
+
+// ParseActionsYAML parses actions from YAML.
+func ParseActionsYAML(data []byte) (Actions, error) {
+	var actions Actions
+	if err := yaml.Unmarshal(data, &actions); err != nil {
+		return nil, fmt.Errorf("sfml: parse actions: %w", err)
+	}
+	if actions == nil {
+		actions = Actions{}
+	}
+	return actions, nil
+}
+
+// ParseActions parses actions from JSON.
+func ParseActions(data []byte) (Actions, error) {
+	var actions Actions
+	if err := json.Unmarshal(data, &actions); err != nil {
+		return nil, fmt.Errorf("sfml: parse actions: %w", err)
+	}
+	if actions == nil {
+		actions = Actions{}
+	}
+	return actions, nil
+}
+
+// SetActions replaces all bound actions.
+func (i *Input) SetActions(actions Actions) {
+	i.actions = actions
+}
+
+// Bind adds binding to the action called name.
+func (i *Input) Bind(name string, binding Binding) {
+	if i.actions == nil {
+		i.actions = Actions{}
+	}
+	i.actions[name] = append(i.actions[name], binding)
+}
+
+// Unbind removes all bindings of the action called name.
+func (i *Input) Unbind(name string) {
+	delete(i.actions, name)
+}
+
+// ActionPressed reports whether any binding of the action is down.
+func (i *Input) ActionPressed(name string) bool {
+	for _, binding := range i.actions[name] {
+		if i.bindingDown(binding, i.keys.pressed, i.buttons.pressed) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActionJustPressed reports whether the action became active this frame.
+func (i *Input) ActionJustPressed(name string) bool {
+	return i.ActionPressed(name) && !i.actionWasPressed(name)
+}
+
+// ActionJustReleased reports whether the action stopped being active this frame.
+func (i *Input) ActionJustReleased(name string) bool {
+	return !i.ActionPressed(name) && i.actionWasPressed(name)
+}
+
+// ActionHeld reports whether the action has been active since before this
+// frame.
+func (i *Input) ActionHeld(name string) bool {
+	return i.ActionPressed(name) && i.actionWasPressed(name)
+}
+
+func (i *Input) actionWasPressed(name string) bool {
+	for _, binding := range i.actions[name] {
+		if i.bindingDown(binding, i.keys.wasPressed, i.buttons.wasPressed) {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Input) bindingDown(binding Binding, key func(KeyCode) bool, button func(MouseButton) bool) bool {
+	if len(binding.Keys) == 0 && len(binding.Buttons) == 0 {
+		return false
+	}
+	for _, k := range binding.Keys {
+		if !key(k) {
+			return false
+		}
+	}
+	for _, b := range binding.Buttons {
+		if !button(b) {
+			return false
+		}
+	}
+	return true
+}
+
+// buttonStates tracks the up/down state of one kind of button across frames.
+type buttonStates[T comparable] struct {
+	down     map[T]bool
+	wasDown  map[T]bool // down at the start of the frame
+	presses  map[T]bool
+	releases map[T]bool
+}
+
+func newButtonStates[T comparable]() buttonStates[T] {
+	return buttonStates[T]{
+		down:     map[T]bool{},
+		wasDown:  map[T]bool{},
+		presses:  map[T]bool{},
+		releases: map[T]bool{},
+	}
+}
+
+func (s *buttonStates[T]) press(b T) {
+	// Key repeat sends more press events while the key stays down; only the
+	// first one starts a press.
+	if s.down[b] {
+		return
+	}
+	s.down[b] = true
+	s.presses[b] = true
+}
+
+func (s *buttonStates[T]) release(b T) {
+	if !s.down[b] {
+		return
+	}
+	delete(s.down, b)
+	s.releases[b] = true
+}
+
+func (s *buttonStates[T]) releaseAll() {
+	for b := range s.down {
+		s.release(b)
+	}
+}
+
+func (s *buttonStates[T]) endFrame() {
+	clear(s.presses)
+	clear(s.releases)
+	clear(s.wasDown)
+	for b := range s.down {
+		s.wasDown[b] = true
+	}
+}
+
+func (s *buttonStates[T]) pressed(b T) bool {
+	return s.down[b]
+}
+
+func (s *buttonStates[T]) justPressed(b T) bool {
+	return s.presses[b]
+}
+
+func (s *buttonStates[T]) justReleased(b T) bool {
+	return s.releases[b]
+}
+
+func (s *buttonStates[T]) held(b T) bool {
+	return s.down[b] && !s.presses[b]
+}
+
+// wasPressed reports whether b was down at the start of the frame.
+func (s *buttonStates[T]) wasPressed(b T) bool {
+	return s.wasDown[b]
+}
@@ -0,0 +1,149 @@
+package sfml
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func keyEvent(eventType EventType, code KeyCode) *KeyEvent {
+	return &KeyEvent{Type: eventType, Code: code}
+}
+
+func TestInputKeyStates(t *testing.T) {
+	type state struct{ pressed, justPressed, justReleased, held bool }
+	tests := []struct {
+		name     string
+		previous []EventType // Events of the previous frame
+		current  []EventType // Events of the current frame
+		want     state
+	}{
+		{name: "up", want: state{}},
+		{name: "pressed", current: []EventType{EvtKeyPressed}, want: state{pressed: true, justPressed: true}},
+		{name: "held", previous: []EventType{EvtKeyPressed}, want: state{pressed: true, held: true}},
+		{name: "repeat", previous: []EventType{EvtKeyPressed}, current: []EventType{EvtKeyPressed}, want: state{pressed: true, held: true}},
+		{name: "released", previous: []EventType{EvtKeyPressed}, current: []EventType{EvtKeyReleased}, want: state{justReleased: true}},
+		{name: "tapped", current: []EventType{EvtKeyPressed, EvtKeyReleased}, want: state{justPressed: true, justReleased: true}},
+		{name: "re-pressed", previous: []EventType{EvtKeyPressed}, current: []EventType{EvtKeyReleased, EvtKeyPressed}, want: state{pressed: true, justPressed: true, justReleased: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := NewInput()
+			for _, frame := range [][]EventType{tt.previous, tt.current} {
+				input.Update()
+				for _, eventType := range frame {
+					input.Handle(keyEvent(eventType, KeyA))
+				}
+			}
+			got := state{
+				pressed:      input.KeyPressed(KeyA),
+				justPressed:  input.KeyJustPressed(KeyA),
+				justReleased: input.KeyJustReleased(KeyA),
+				held:         input.KeyHeld(KeyA),
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInputActions(t *testing.T) {
+	actions, err := ParseActions([]byte(`{
+		"jump": [{"keys": ["KeySpace"]}, {"buttons": ["MouseRight"]}],
+		"dash": [{"keys": ["KeyD", "KeySpace"]}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := NewInput()
+	input.SetActions(actions)
+
+	input.Update()
+	input.Handle(keyEvent(EvtKeyPressed, KeySpace))
+	if !input.ActionJustPressed("jump") || input.ActionPressed("dash") {
+		t.Error("space alone should press jump but not dash")
+	}
+
+	// Releasing and pressing again within a frame keeps the action down, so it
+	// is neither just pressed nor just released
+	input.Update()
+	input.Handle(keyEvent(EvtKeyReleased, KeySpace))
+	input.Handle(keyEvent(EvtKeyPressed, KeySpace))
+	if !input.ActionHeld("jump") || input.ActionJustPressed("jump") || input.ActionJustReleased("jump") {
+		t.Error("jump should stay held across a release and press in one frame")
+	}
+
+	input.Update()
+	input.Handle(&MouseButtonEvent{Type: EvtMouseButtonPressed, Button: MouseRight})
+	input.Handle(keyEvent(EvtKeyReleased, KeySpace))
+	if !input.ActionHeld("jump") {
+		t.Error("jump should stay held when another binding takes over")
+	}
+
+	input.Update()
+	input.Handle(&LostFocusEvent{Type: EvtLostFocus})
+	if !input.ActionJustReleased("jump") || input.ButtonPressed(MouseRight) {
+		t.Error("losing focus should release everything")
+	}
+}
+
+func TestParseActionsInvalid(t *testing.T) {
+	if _, err := ParseActions([]byte(`{"jump": [{"keys": ["KeyNoSuchKey"]}]}`)); err == nil {
+		t.Error("ParseActions accepted an unknown key name")
+	}
+	actions, err := ParseActions([]byte(`null`))
+	if err != nil || actions == nil {
+		t.Errorf("ParseActions(null) = %v, %v, want empty actions", actions, err)
+	}
+}
+
+func TestParseActionsYAML(t *testing.T) {
+	data, err := os.ReadFile("testdata/actions.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	actions, err := ParseActionsYAML(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Actions{
+		"jump": {{Keys: []KeyCode{KeySpace}}, {Buttons: []MouseButton{MouseRight}}},
+		"dash": {{Keys: []KeyCode{KeyD, KeySpace}}},
+		"save": {{Keys: []KeyCode{KeyLcOntrol, KeyS}}},
+	}
+	if !reflect.DeepEqual(actions, want) {
+		t.Errorf("ParseActionsYAML = %v, want %v", actions, want)
+	}
+
+	if _, err := ParseActionsYAML([]byte("jump:\n  - keys: [KeyNoSuchKey]\n")); err == nil {
+		t.Error("ParseActionsYAML accepted an unknown key name")
+	}
+	actions, err = ParseActionsYAML(nil)
+	if err != nil || actions == nil {
+		t.Errorf("ParseActionsYAML(nil) = %v, %v, want empty actions", actions, err)
+	}
+}
+
+func TestInputMouse(t *testing.T) {
+	input := NewInput()
+	input.Update()
+	input.Handle(&MouseMoveEvent{Type: EvtMouseMoved, X: 10, Y: 20})
+	input.Handle(&MouseMoveEvent{Type: EvtMouseMoved, X: 15, Y: 18})
+	input.Handle(&MouseWheelScrollEvent{Type: EvtMouseWheelScrolled, Wheel: MouseVerticalWheel, Delta: 1.5, X: 15, Y: 18})
+	if got, want := input.MousePosition(), (Vector2i{X: 15, Y: 18}); got != want {
+		t.Errorf("MousePosition() = %v, want %v", got, want)
+	}
+	// The first move only sets the position
+	if got, want := input.MouseDelta(), (Vector2i{X: 5, Y: -2}); got != want {
+		t.Errorf("MouseDelta() = %v, want %v", got, want)
+	}
+	if got := input.Wheel(MouseVerticalWheel); got != 1.5 {
+		t.Errorf("Wheel(vertical) = %v, want 1.5", got)
+	}
+
+	input.Update()
+	if input.MouseDelta() != (Vector2i{}) || input.Wheel(MouseVerticalWheel) != 0 {
+		t.Error("Update should reset the mouse delta and wheel")
+	}
+}
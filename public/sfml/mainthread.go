@@ -0,0 +1,102 @@
+package sfml
+
+// #include <pthread.h>
+//
+// static unsigned long long sfml_current_thread(void) {
+//     return (unsigned long long)pthread_self();
+// }
+import "C"
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// SFML requires windowing, event and OpenGL calls to happen on the thread that
+// created the window, which on some platforms must be the process's main thread.
+// Package initialization runs on the main goroutine, so locking it here keeps the
+// main goroutine on the main thread for the life of the program.
+func init() {
+	runtime.LockOSThread()
+	mainThread = C.sfml_current_thread()
+}
+
+var (
+	mainThread      C.ulonglong
+	mainThreadCalls = make(chan func())
+	// mainStopped holds the channel Main closes when it returns, or nil while Main
+	// is not running
+	mainStopped atomic.Pointer[chan struct{}]
+)
+
+// onMainThread reports whether the caller runs on the main thread.
+func onMainThread() bool {
+	return C.sfml_current_thread() == mainThread
+}
+
+// Main runs run on a new goroutine and serves Do calls on the main thread until
+// run returns. It must be called from the main goroutine, usually as the only
+// statement of func main.
+func Main(run func()) {
+	stopped := make(chan struct{})
+	mainStopped.Store(&stopped)
+	defer func() {
+		mainStopped.Store(nil)
+		close(stopped)
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		run()
+	}()
+
+	for {
+		select {
+		case f := <-mainThreadCalls:
+			f()
+		case <-done:
+			return
+		}
+	}
+}
+
+// Do runs f on the main thread and waits for it to return. A panic in f is
+// re-raised in the calling goroutine. Called on the main thread, e.g. from f
+// itself, Do runs f right away. From other goroutines, Do panics if Main is not
+// running or returns before taking the call, as nothing would serve it.
+func Do(f func()) {
+	if onMainThread() {
+		f()
+		return
+	}
+	stopped := mainStopped.Load()
+	if stopped == nil {
+		panic("sfml: Do called while Main is not running")
+	}
+
+	done := make(chan any, 1)
+	call := func() {
+		defer func() {
+			done <- recover()
+		}()
+		f()
+	}
+	// Main may return after the check above, and then nothing receives the call
+	select {
+	case mainThreadCalls <- call:
+	case <-*stopped:
+		panic("sfml: Do called while Main is not running")
+	}
+	if p := <-done; p != nil {
+		panic(p)
+	}
+}
+
+// Call runs f on the main thread like Do and returns its result.
+func Call[T any](f func() T) T {
+	var res T
+	Do(func() {
+		res = f()
+	})
+	return res
+}
@@ -0,0 +1,17 @@
+//go:build sfmldebug
+
+package sfml
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// Built with -tags sfmldebug, the wrappers of windowing, input and context
+// functions check that they run on the main thread.
+
+func assertMainThread() {
+	if !onMainThread() {
+		panic(fmt.Sprintf("sfml: called off the main thread, use sfml.Do\n%s", debug.Stack()))
+	}
+}
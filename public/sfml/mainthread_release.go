@@ -0,0 +1,5 @@
+//go:build !sfmldebug
+
+package sfml
+
+func assertMainThread() {}
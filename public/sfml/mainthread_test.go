@@ -0,0 +1,86 @@
+package sfml
+
+import (
+	"os"
+	"testing"
+)
+
+// The tests run on other goroutines, with Main serving the main thread.
+func TestMain(m *testing.M) {
+	code := 0
+	Main(func() {
+		code = m.Run()
+	})
+	os.Exit(code)
+}
+
+func TestDoRunsOnMainThread(t *testing.T) {
+	if onMainThread() {
+		t.Fatal("test goroutine runs on the main thread")
+	}
+	if !Call(onMainThread) {
+		t.Error("Call ran f off the main thread")
+	}
+}
+
+func TestDoNested(t *testing.T) {
+	ran := false
+	Do(func() {
+		// Would deadlock if the inner Do waited for the main thread
+		Do(func() {
+			ran = onMainThread()
+		})
+	})
+	if !ran {
+		t.Error("nested Do did not run f on the main thread")
+	}
+}
+
+func TestDoPanic(t *testing.T) {
+	defer func() {
+		if p := recover(); p != "boom" {
+			t.Errorf("recovered %v, want the panic of f", p)
+		}
+	}()
+	Do(func() {
+		panic("boom")
+	})
+}
+
+func TestDoWithoutMain(t *testing.T) {
+	stopped := mainStopped.Swap(nil)
+	defer mainStopped.Store(stopped)
+	defer func() {
+		if recover() == nil {
+			t.Error("Do without Main did not panic")
+		}
+	}()
+	Do(func() {})
+}
+
+// Main returning after Do saw it running must not leave Do blocked on a call
+// nothing receives.
+func TestDoWhenMainStops(t *testing.T) {
+	// Keep the main thread busy, so it can't take the call below
+	busy, release := make(chan struct{}), make(chan struct{})
+	go Do(func() {
+		close(busy)
+		<-release
+	})
+	<-busy
+	defer close(release)
+
+	stopped := make(chan struct{})
+	close(stopped)
+	running := mainStopped.Swap(&stopped)
+	defer mainStopped.Store(running)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Do did not panic once Main stopped")
+		}
+	}()
+	Do(func() {
+		t.Error("f ran after Main stopped")
+	})
+}
@@ -0,0 +1,38 @@
+package sfml
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// A nil window of any type must reach C as NULL, meaning the desktop, instead of
+// being dereferenced by ToC. The positions come from the live mouse, so only the
+// absence of a panic is checked.
+func TestMouseNilWindows(t *testing.T) {
+	if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+		t.Skip("needs a display, e.g. Xvfb")
+	}
+
+	for _, relativeTo := range []WindowLike{nil, (*Window)(nil), (*RenderWindow)(nil), (*WindowBase)(nil)} {
+		if MouseGetPosition(relativeTo) == nil {
+			t.Errorf("MouseGetPosition(%T(nil)) = nil", relativeTo)
+		}
+		if TouchGetPosition(0, relativeTo) == nil {
+			t.Errorf("TouchGetPosition(0, %T(nil)) = nil", relativeTo)
+		}
+	}
+}
+
+type foreignWindow struct{}
+
+func (foreignWindow) SystemHandle() uintptr { return 0 }
+
+func TestMouseForeignWindow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(r.(string), "does not support this WindowLike implementation") {
+			t.Errorf("recovered %v, want an unsupported implementation panic", r)
+		}
+	}()
+	MouseGetPosition(foreignWindow{})
+}
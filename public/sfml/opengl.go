@@ -0,0 +1,81 @@
+package sfml
+
+// #include <stdlib.h>
+// #include <SFML/Window/Context.h>
+import "C"
+import (
+	"strings"
+	"unsafe"
+)
+
+// ContextGetFunction returns the address of the OpenGL function called name, or
+// nil if the active context does not provide it. A context must be active on
+// the calling thread, e.g. after RenderWindow.SetActive(true).
+func ContextGetFunction(name string) unsafe.Pointer {
+	assertMainThread()
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	return unsafe.Pointer(C.sfContext_getFunction(cName))
+}
+
+// GLProcAddress resolves OpenGL entry points through SFML. Its signature matches
+// the loader hook of generated Go GL bindings, e.g.
+//
+//	gl.InitWithProcAddrFunc(sfml.GLProcAddress)
+//
+// Trailing NUL bytes, which some loaders append, are ignored.
+func GLProcAddress(name string) unsafe.Pointer {
+	return ContextGetFunction(glFunctionName(name))
+}
+
+func glFunctionName(name string) string {
+	return strings.TrimRight(name, "\x00")
+}
+
+// Raw OpenGL calls mixed with SFML drawing should be bracketed by the
+// PushGLStates and PopGLStates methods of RenderWindow and RenderTexture, so
+// that neither side clobbers the other's state. ResetGLStates restores SFML's
+// defaults instead, when the raw OpenGL code does not need its own state
+// preserved.
+
+// PushGlsTates saves the current OpenGL render states and matrices.
+//
+// Deprecated: Use PushGLStates.
+func (r *RenderWindow) PushGlsTates() {
+	r.PushGLStates()
+}
+
+// PopGlsTates restores the OpenGL states saved by the matching PushGLStates.
+//
+// Deprecated: Use PopGLStates.
+func (r *RenderWindow) PopGlsTates() {
+	r.PopGLStates()
+}
+
+// ResetGlsTates resets the OpenGL states to SFML's defaults.
+//
+// Deprecated: Use ResetGLStates.
+func (r *RenderWindow) ResetGlsTates() {
+	r.ResetGLStates()
+}
+
+// PushGlsTates saves the current OpenGL render states and matrices.
+//
+// Deprecated: Use PushGLStates.
+func (r *RenderTexture) PushGlsTates() {
+	r.PushGLStates()
+}
+
+// PopGlsTates restores the OpenGL states saved by the matching PushGLStates.
+//
+// Deprecated: Use PopGLStates.
+func (r *RenderTexture) PopGlsTates() {
+	r.PopGLStates()
+}
+
+// ResetGlsTates resets the OpenGL states to SFML's defaults.
+//
+// Deprecated: Use ResetGLStates.
+func (r *RenderTexture) ResetGlsTates() {
+	r.ResetGLStates()
+}
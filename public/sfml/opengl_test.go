@@ -0,0 +1,43 @@
+package sfml
+
+import (
+	"os"
+	"testing"
+)
+
+func TestGLFunctionName(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{name: "glClear", want: "glClear"},
+		{name: "glClear\x00", want: "glClear"},
+		{name: "glClear\x00\x00", want: "glClear"},
+		{name: "", want: ""},
+	}
+	for _, tt := range tests {
+		if got := glFunctionName(tt.name); got != tt.want {
+			t.Errorf("glFunctionName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestContextGetFunction(t *testing.T) {
+	if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+		t.Skip("needs a display, e.g. a software GL context under Xvfb")
+	}
+	context := NewContext()
+	defer context.Free()
+	if !context.SetActive(true) {
+		t.Skip("no OpenGL context available")
+	}
+
+	if ContextGetFunction("glClear") == nil {
+		t.Error("ContextGetFunction(glClear) = nil, want an address")
+	}
+	if GLProcAddress("glClear\x00") == nil {
+		t.Error("GLProcAddress(glClear\\x00) = nil, want an address")
+	}
+	if p := ContextGetFunction("glNoSuchFunctionSFML"); p != nil {
+		t.Errorf("ContextGetFunction of a missing symbol = %v, want nil", p)
+	}
+}
@@ -0,0 +1,220 @@
+package sfml
+
+// #include <SFML/Window/Event.h>
+import "C"
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"time"
+)
+
+// Events are stored as JSON, one RecordedEvent per line, with enum values by
+// name so recordings stay readable and survive changes to the C values:
+//
+//	{"time":1500000,"event":{"Type":"EvtKeyPressed","Code":"KeyA",...}}
+//
+// UnmarshalEvent builds the C event of a replayed event from its fields, so
+// BaseToC returns what SFML would have, type included.
+
+// RecordedEvent is an event with the time it was polled, relative to the start
+// of the recording.
+type RecordedEvent struct {
+	Time  time.Duration
+	Event Event
+}
+
+type recordedEventJSON struct {
+	Time  time.Duration   `json:"time"`
+	Event json.RawMessage `json:"event"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r RecordedEvent) MarshalJSON() ([]byte, error) {
+	event, err := MarshalEvent(r.Event)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(recordedEventJSON{Time: r.Time, Event: event})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RecordedEvent) UnmarshalJSON(data []byte) error {
+	var raw recordedEventJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	event, err := UnmarshalEvent(raw.Event)
+	if err != nil {
+		return err
+	}
+	*r = RecordedEvent{Time: raw.Time, Event: event}
+	return nil
+}
+
+// MarshalEvent encodes event as JSON.
+func MarshalEvent(event Event) ([]byte, error) {
+	if event == nil {
+		return nil, errors.New("sfml: marshal nil event")
+	}
+	return json.Marshal(event)
+}
+
+// UnmarshalEvent decodes an event encoded by MarshalEvent into the struct
+// matching its type.
+func UnmarshalEvent(data []byte) (Event, error) {
+	// A pointer tells a missing type, or a null event, from EvtClosed, which is 0
+	var header struct {
+		Type *EventType
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("sfml: unmarshal event: %w", err)
+	}
+	if header.Type == nil {
+		return nil, errors.New("sfml: unmarshal event: missing type")
+	}
+	event := newEventOfType(*header.Type)
+	if event == nil {
+		return nil, fmt.Errorf("sfml: unmarshal event: unsupported type %v", *header.Type)
+	}
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("sfml: unmarshal %v event: %w", *header.Type, err)
+	}
+	return withCEvent(event), nil
+}
+
+// withCEvent gives an event made in Go the C event built from its fields.
+func withCEvent(event Event) Event {
+	event.(interface{ setCObj(C.sfEvent) }).setCObj(eventToC(event))
+	return event
+}
+
+// setCObj sets the C event behind an event made in Go.
+func (b *BaseEvent) setCObj(cObj C.sfEvent) {
+	b.cObj = cObj
+}
+
+// WriteEvents writes events to w, one per line.
+func WriteEvents(w io.Writer, events []RecordedEvent) error {
+	bw := bufio.NewWriter(w)
+	encoder := json.NewEncoder(bw)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadEvents reads events written by WriteEvents.
+func ReadEvents(r io.Reader) ([]RecordedEvent, error) {
+	var events []RecordedEvent
+	decoder := json.NewDecoder(r)
+	for {
+		var event RecordedEvent
+		err := decoder.Decode(&event)
+		if errors.Is(err, io.EOF) {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+}
+
+// EventRecorder records the events polled from a source, timed by a Clock
+// started when the recorder is created. Poll the recorder instead of the
+// source.
+type EventRecorder struct {
+	source EventSource
+	clock  *Clock
+	events []RecordedEvent
+}
+
+var _ EventSource = (*EventRecorder)(nil)
+
+// NewEventRecorder creates a recorder for the events of source.
+func NewEventRecorder(source EventSource) *EventRecorder {
+	return &EventRecorder{source: source, clock: NewClock()}
+}
+
+// Free frees the recorder's clock.
+func (r *EventRecorder) Free() {
+	r.clock.Free()
+}
+
+// PollEvent polls the source and records the event it returns.
+func (r *EventRecorder) PollEvent() (Event, bool) {
+	event, ok := r.source.PollEvent()
+	if ok && event != nil {
+		r.events = append(r.events, RecordedEvent{Time: r.clock.ElapsedTime(), Event: event})
+	}
+	return event, ok
+}
+
+// Events returns an iterator over all pending events, recording them.
+func (r *EventRecorder) Events() iter.Seq[Event] {
+	return pendingEvents(r)
+}
+
+// Recorded returns the events recorded so far.
+func (r *EventRecorder) Recorded() []RecordedEvent {
+	return r.events
+}
+
+// EventReplay plays recorded events back as an EventSource. Its time only moves
+// with Advance, so a replay is deterministic and needs no window:
+//
+//	replay := sfml.NewEventReplay(events)
+//	for !replay.Done() {
+//		replay.Advance(time.Second / 60)
+//		for event := range replay.Events() {
+//			...
+//		}
+//	}
+type EventReplay struct {
+	events []RecordedEvent
+	next   int
+	now    time.Duration
+}
+
+var _ EventSource = (*EventReplay)(nil)
+
+// NewEventReplay creates a replay of events, which must be sorted by time.
+func NewEventReplay(events []RecordedEvent) *EventReplay {
+	return &EventReplay{events: events}
+}
+
+// Advance moves the replay time forward by d.
+func (r *EventReplay) Advance(d time.Duration) {
+	r.now += d
+}
+
+// Now returns the replay time.
+func (r *EventReplay) Now() time.Duration {
+	return r.now
+}
+
+// PollEvent returns the next event recorded at or before the replay time.
+func (r *EventReplay) PollEvent() (Event, bool) {
+	if r.next >= len(r.events) || r.events[r.next].Time > r.now {
+		return nil, false
+	}
+	event := r.events[r.next].Event
+	r.next++
+	return event, true
+}
+
+// Events returns an iterator over all events due at the replay time.
+func (r *EventReplay) Events() iter.Seq[Event] {
+	return pendingEvents(r)
+}
+
+// Done reports whether all events have been played.
+func (r *EventReplay) Done() bool {
+	return r.next >= len(r.events)
+}
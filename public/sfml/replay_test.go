@@ -0,0 +1,155 @@
+package sfml
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sampleEvents holds one event of every struct, with every field set.
+var sampleEvents = []Event{
+	&ClosedEvent{Type: EvtClosed},
+	&LostFocusEvent{Type: EvtLostFocus},
+	&GainedFocusEvent{Type: EvtGainedFocus},
+	&MouseEnteredEvent{Type: EvtMouseEntered},
+	&MouseLeftEvent{Type: EvtMouseLeft},
+	&SizeEvent{Type: EvtResized, Width: 1280, Height: 720},
+	&KeyEvent{Type: EvtKeyPressed, Code: KeyW, Scancode: ScanW, Alt: true, Control: true, Shift: true, System: true},
+	&KeyEvent{Type: EvtKeyReleased, Code: KeyEscape, Scancode: ScanEscape},
+	&TextEvent{Type: EvtTextEntered, Unicode: 0x1F3AE},
+	&MouseMoveEvent{Type: EvtMouseMoved, X: -3, Y: 42},
+	&MouseButtonEvent{Type: EvtMouseButtonPressed, Button: MouseMiddle, X: 10, Y: 20},
+	&MouseButtonEvent{Type: EvtMouseButtonReleased, Button: MouseRight, X: 11, Y: 21},
+	&MouseWheelEvent{Type: EvtMouseWheelMoved, Delta: -2, X: 5, Y: 6},
+	&MouseWheelScrollEvent{Type: EvtMouseWheelScrolled, Wheel: MouseHorizontalWheel, Delta: 0.5, X: 7, Y: 8},
+	&TouchEvent{Type: EvtTouchBegan, Finger: 1, X: 100, Y: 200},
+	&TouchEvent{Type: EvtTouchMoved, Finger: 1, X: 101, Y: 201},
+	&TouchEvent{Type: EvtTouchEnded, Finger: 1, X: 102, Y: 202},
+	&SensorEvent{Type: EvtSensorChanged, SensorType: SensorGyroscope, X: 0.25, Y: -1, Z: 9.5},
+}
+
+func TestMarshalEventRoundTrip(t *testing.T) {
+	for _, event := range sampleEvents {
+		data, err := MarshalEvent(event)
+		if err != nil {
+			t.Fatalf("MarshalEvent(%T) failed: %v", event, err)
+		}
+		decoded, err := UnmarshalEvent(data)
+		if err != nil {
+			t.Fatalf("UnmarshalEvent(%s) failed: %v", data, err)
+		}
+		if !reflect.DeepEqual(decoded, withCEvent(event)) {
+			t.Errorf("%s decoded to %+v, want %+v", data, decoded, event)
+		}
+
+		// The C event carries the type and fields, so converting it back gives the
+		// same event
+		if fromC := NewEventFromC(decoded.BaseToC()); !reflect.DeepEqual(fromC, decoded) {
+			t.Errorf("%s: NewEventFromC(BaseToC()) = %+v, want %+v", data, fromC, decoded)
+		}
+	}
+}
+
+func TestMarshalEventNames(t *testing.T) {
+	data, err := MarshalEvent(&KeyEvent{Type: EvtKeyPressed, Code: KeyA, Scancode: ScanA})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{`"Type":"EvtKeyPressed"`, `"Code":"KeyA"`, `"Scancode":"ScanA"`} {
+		if !bytes.Contains(data, []byte(name)) {
+			t.Errorf("%s does not contain %s", data, name)
+		}
+	}
+	if _, err := MarshalEvent(nil); err == nil {
+		t.Error("MarshalEvent(nil) succeeded")
+	}
+}
+
+func TestUnmarshalEventInvalid(t *testing.T) {
+	for _, data := range []string{
+		`null`,
+		`{}`,
+		`{"Code":"KeyA"}`,
+		`{"Type":"EvtNoSuchEvent"}`,
+		`{"Type":"EvtCount"}`,
+		`{"Type":"EvtKeyPressed","Code":"KeyNoSuchKey"}`,
+		`[]`,
+	} {
+		if event, err := UnmarshalEvent([]byte(data)); err == nil {
+			t.Errorf("UnmarshalEvent(%s) = %+v, want an error", data, event)
+		}
+	}
+}
+
+func TestReadEventsNull(t *testing.T) {
+	for _, data := range []string{
+		`{"time":0,"event":null}`,
+		`{"time":0}`,
+	} {
+		if events, err := ReadEvents(strings.NewReader(data)); err == nil {
+			t.Errorf("ReadEvents(%s) = %+v, want an error", data, events)
+		}
+	}
+}
+
+func TestEventReplayRoundTrip(t *testing.T) {
+	recorded := make([]RecordedEvent, len(sampleEvents))
+	for i, event := range sampleEvents {
+		recorded[i] = RecordedEvent{Time: time.Duration(i/3) * 10 * time.Millisecond, Event: event}
+	}
+
+	var buf bytes.Buffer
+	if err := WriteEvents(&buf, recorded); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != len(recorded) {
+		t.Errorf("wrote %d lines, want %d", lines, len(recorded))
+	}
+	read, err := ReadEvents(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != len(recorded) {
+		t.Fatalf("read %d events, want %d", len(read), len(recorded))
+	}
+
+	// Three events per 10ms step, all due at once when their time comes
+	replay := NewEventReplay(read)
+	var replayed []Event
+	for step := 0; !replay.Done(); step++ {
+		var frame []Event
+		for event := range replay.Events() {
+			frame = append(frame, event)
+		}
+		if want := min(3, len(recorded)-3*step); len(frame) != want {
+			t.Fatalf("step %d at %v replayed %d events, want %d", step, replay.Now(), len(frame), want)
+		}
+		replayed = append(replayed, frame...)
+		replay.Advance(5 * time.Millisecond)
+		for event := range replay.Events() {
+			t.Fatalf("replayed %+v between recorded times", event)
+		}
+		replay.Advance(5 * time.Millisecond)
+	}
+	for i, event := range replayed {
+		if read[i].Time != recorded[i].Time || !reflect.DeepEqual(event, withCEvent(recorded[i].Event)) {
+			t.Errorf("event %d = %+v at %v, want %+v at %v", i, event, read[i].Time, recorded[i].Event, recorded[i].Time)
+		}
+	}
+}
+
+func TestEmptyReplay(t *testing.T) {
+	events, err := ReadEvents(strings.NewReader(""))
+	if err != nil || len(events) != 0 {
+		t.Fatalf("ReadEvents(\"\") = %v, %v", events, err)
+	}
+	replay := NewEventReplay(events)
+	if !replay.Done() {
+		t.Error("empty replay is not done")
+	}
+	if event, ok := replay.PollEvent(); ok {
+		t.Errorf("empty replay polled %+v", event)
+	}
+}
@@ -0,0 +1,298 @@
+package sfml
+
+// #include <SFML/Graphics.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// ResourceCache loads textures, fonts, images and shaders from an fs.FS once and
+// shares them between users. Each Acquire adds a reference and each Release
+// removes one; the resource is freed when the last reference is released. Use
+// os.DirFS for files on disk, or an embed.FS.
+//
+// Loading creates OpenGL resources, so like the New functions it replaces, the
+// cache must be used from the render thread.
+type ResourceCache struct {
+	FS fs.FS
+	// ShaderDefines are injected into every shader of the cache. The shader
+	// sources are preprocessed, so they may also use #include, see
+	// ShaderPreprocessor.
+	ShaderDefines map[string]string
+
+	mu      sync.Mutex
+	entries map[resourceKey]*resourceEntry
+	handles map[any]*resourceEntry
+	groups  map[string][]any
+	stats   ResourceStats
+}
+
+type resourceKey struct {
+	kind string
+	name string
+}
+
+type resourceEntry struct {
+	key    resourceKey
+	handle any
+	refs   int
+	free   func()
+}
+
+// ResourceStats counts what a ResourceCache holds and did.
+type ResourceStats struct {
+	Resources  int // Resources currently loaded
+	References int // References currently held, group references included
+	Loads      int // Acquires that loaded a resource
+	Hits       int // Acquires that reused a loaded resource
+	Frees      int // Resources freed after their last release
+}
+
+// ResourceLoader loads a resource from fsys and returns it with the function
+// that frees it.
+type ResourceLoader[T comparable] func(fsys fs.FS, name string) (T, func(), error)
+
+// NewResourceCache creates a cache that loads from fsys.
+func NewResourceCache(fsys fs.FS) *ResourceCache {
+	return &ResourceCache{
+		FS:      fsys,
+		entries: map[resourceKey]*resourceEntry{},
+		handles: map[any]*resourceEntry{},
+		groups:  map[string][]any{},
+	}
+}
+
+// AcquireTexture returns the texture loaded from name, loading it if needed.
+func (c *ResourceCache) AcquireTexture(name string) (*Texture, error) {
+	return AcquireResource(c, "texture", name, loadTexture)
+}
+
+// AcquireFont returns the font loaded from name, loading it if needed.
+func (c *ResourceCache) AcquireFont(name string) (*Font, error) {
+	return AcquireResource(c, "font", name, loadFont)
+}
+
+// AcquireImage returns the image loaded from name, loading it if needed. The
+// image is shared, so modifying it affects every user.
+func (c *ResourceCache) AcquireImage(name string) (*Image, error) {
+	return AcquireResource(c, "image", name, loadImage)
+}
+
+// AcquireShader returns the shader compiled from the given files, compiling it
+// if needed. An empty name skips that stage.
+func (c *ResourceCache) AcquireShader(vertexShader, geometryShader, fragmentShader string) (*Shader, error) {
+	name := strings.Join([]string{vertexShader, geometryShader, fragmentShader}, "|")
+	return AcquireResource(c, "shader", name, func(fsys fs.FS, _ string) (*Shader, func(), error) {
+		shader, err := NewShaderPreprocessor(fsys, c.ShaderDefines).NewShader(vertexShader, geometryShader, fragmentShader)
+		if err != nil {
+			return nil, nil, err
+		}
+		return shader, shader.Free, nil
+	})
+}
+
+// AcquireResource returns the resource of kind loaded from name, loading it with
+// load if needed. It lets the cache hold types it has no Acquire method for,
+// like sound buffers. The kind keeps resources of different types loaded from
+// the same file apart. load runs with the cache locked, so it must not use the
+// cache.
+func AcquireResource[T comparable](c *ResourceCache, kind, name string, load ResourceLoader[T]) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := resourceKey{kind: kind, name: name}
+	if entry, ok := c.entries[key]; ok {
+		entry.refs++
+		c.stats.References++
+		c.stats.Hits++
+		return entry.handle.(T), nil
+	}
+
+	handle, free, err := load(c.FS, name)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	entry := &resourceEntry{key: key, handle: handle, refs: 1, free: free}
+	c.entries[key] = entry
+	c.handles[handle] = entry
+	c.stats.Resources++
+	c.stats.References++
+	c.stats.Loads++
+	return handle, nil
+}
+
+// Release drops a reference to a resource returned by the cache, freeing it if
+// it was the last one. The handle must not be used after its last release.
+func (c *ResourceCache) Release(handle any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.release(handle)
+}
+
+func (c *ResourceCache) release(handle any) {
+	entry, ok := c.handles[handle]
+	if !ok {
+		panic(fmt.Sprintf("sfml: releasing %T that the resource cache doesn't hold", handle))
+	}
+	entry.refs--
+	c.stats.References--
+	if entry.refs > 0 {
+		return
+	}
+
+	delete(c.entries, entry.key)
+	delete(c.handles, handle)
+	entry.free()
+	c.stats.Resources--
+	c.stats.Frees++
+}
+
+// ResourceRequest names a resource to preload, see Preload.
+type ResourceRequest struct {
+	acquire func(c *ResourceCache) (any, error)
+}
+
+// TextureRequest requests the texture loaded from name.
+func TextureRequest(name string) ResourceRequest {
+	return ResourceRequest{acquire: func(c *ResourceCache) (any, error) {
+		return c.AcquireTexture(name)
+	}}
+}
+
+// FontRequest requests the font loaded from name.
+func FontRequest(name string) ResourceRequest {
+	return ResourceRequest{acquire: func(c *ResourceCache) (any, error) {
+		return c.AcquireFont(name)
+	}}
+}
+
+// ImageRequest requests the image loaded from name.
+func ImageRequest(name string) ResourceRequest {
+	return ResourceRequest{acquire: func(c *ResourceCache) (any, error) {
+		return c.AcquireImage(name)
+	}}
+}
+
+// ShaderRequest requests the shader compiled from the given files.
+func ShaderRequest(vertexShader, geometryShader, fragmentShader string) ResourceRequest {
+	return ResourceRequest{acquire: func(c *ResourceCache) (any, error) {
+		return c.AcquireShader(vertexShader, geometryShader, fragmentShader)
+	}}
+}
+
+// NewResourceRequest requests a resource loaded with a custom loader, see
+// AcquireResource.
+func NewResourceRequest[T comparable](kind, name string, load ResourceLoader[T]) ResourceRequest {
+	return ResourceRequest{acquire: func(c *ResourceCache) (any, error) {
+		return AcquireResource(c, kind, name, load)
+	}}
+}
+
+// Preload acquires the requested resources on behalf of group, so they stay
+// loaded until ReleaseGroup. Acquiring them afterwards is a cache hit. If one
+// fails to load, the ones loaded by this call are released and the error is
+// returned.
+func (c *ResourceCache) Preload(group string, requests ...ResourceRequest) error {
+	handles := make([]any, 0, len(requests))
+	for _, request := range requests {
+		handle, err := request.acquire(c)
+		if err != nil {
+			for _, loaded := range handles {
+				c.Release(loaded)
+			}
+			return err
+		}
+		handles = append(handles, handle)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.groups[group] = append(c.groups[group], handles...)
+	return nil
+}
+
+// ReleaseGroup releases the references Preload acquired for group.
+func (c *ResourceCache) ReleaseGroup(group string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, handle := range c.groups[group] {
+		c.release(handle)
+	}
+	delete(c.groups, group)
+}
+
+// Stats returns the current counts of the cache.
+func (c *ResourceCache) Stats() ResourceStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// The loaders below call the C functions directly, as the generated FromMemory
+// wrappers take the data as a uintptr and require an area for textures.
+
+func loadTexture(fsys fs.FS, name string) (*Texture, func(), error) {
+	data, size, err := readResource(fsys, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer C.free(data)
+
+	texture := NewTextureFromC(C.sfTexture_createFromMemory(data, size, nil))
+	if texture.ToC() == nil {
+		return nil, nil, fmt.Errorf("sfml: failed to load texture %s", name)
+	}
+	return texture, texture.Free, nil
+}
+
+func loadFont(fsys fs.FS, name string) (*Font, func(), error) {
+	data, size, err := readResource(fsys, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// SFML reads the glyphs from the data as they are needed, so it must live as
+	// long as the font
+	font := NewFontFromC(C.sfFont_createFromMemory(data, size))
+	if font.ToC() == nil {
+		C.free(data)
+		return nil, nil, fmt.Errorf("sfml: failed to load font %s", name)
+	}
+	return font, func() {
+		font.Free()
+		C.free(data)
+	}, nil
+}
+
+func loadImage(fsys fs.FS, name string) (*Image, func(), error) {
+	data, size, err := readResource(fsys, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer C.free(data)
+
+	image := NewImageFromC(C.sfImage_createFromMemory(data, size))
+	if image.ToC() == nil {
+		return nil, nil, fmt.Errorf("sfml: failed to load image %s", name)
+	}
+	return image, image.Free, nil
+}
+
+// readResource reads name from fsys into C memory, which the caller must
+// free.
+func readResource(fsys fs.FS, name string) (unsafe.Pointer, C.size_t, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(data) == 0 {
+		return nil, 0, fmt.Errorf("sfml: resource %s is empty", name)
+	}
+	return C.CBytes(data), C.size_t(len(data)), nil
+}
@@ -0,0 +1,219 @@
+package sfml
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// textLoader loads files as strings and records the frees.
+type textLoader struct {
+	loads []string
+	frees []string
+}
+
+type textResource struct {
+	name string
+	text string
+}
+
+func (l *textLoader) load(fsys fs.FS, name string) (*textResource, func(), error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	l.loads = append(l.loads, name)
+	resource := &textResource{name: name, text: string(data)}
+	return resource, func() { l.frees = append(l.frees, name) }, nil
+}
+
+func newTextCache() (*ResourceCache, *textLoader) {
+	fsys := fstest.MapFS{
+		"a.txt": {Data: []byte("a")},
+		"b.txt": {Data: []byte("b")},
+		"c.txt": {Data: []byte("c")},
+	}
+	return NewResourceCache(fsys), &textLoader{}
+}
+
+func TestResourceCacheRefCount(t *testing.T) {
+	c, loader := newTextCache()
+
+	first, err := AcquireResource(c, "text", "a.txt", loader.load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := AcquireResource(c, "text", "a.txt", loader.load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second || first.text != "a" {
+		t.Errorf("second acquire returned %+v, want the first %+v", second, first)
+	}
+	// The kind keeps resources from the same file apart
+	other, err := AcquireResource(c, "other", "a.txt", loader.load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == first {
+		t.Error("resources of different kinds share a handle")
+	}
+	if want := (ResourceStats{Resources: 2, References: 3, Loads: 2, Hits: 1}); c.Stats() != want {
+		t.Errorf("Stats = %+v, want %+v", c.Stats(), want)
+	}
+
+	c.Release(first)
+	if len(loader.frees) != 0 {
+		t.Fatalf("freed %v with a reference left", loader.frees)
+	}
+	c.Release(second)
+	if len(loader.frees) != 1 || loader.frees[0] != "a.txt" {
+		t.Fatalf("frees = %v, want [a.txt]", loader.frees)
+	}
+	if want := (ResourceStats{Resources: 1, References: 1, Loads: 2, Hits: 1, Frees: 1}); c.Stats() != want {
+		t.Errorf("Stats = %+v, want %+v", c.Stats(), want)
+	}
+
+	// Freed resources are loaded again
+	again, err := AcquireResource(c, "text", "a.txt", loader.load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again == first {
+		t.Error("acquire after the last release reused the freed handle")
+	}
+	if len(loader.loads) != 3 {
+		t.Errorf("loads = %v, want 3", loader.loads)
+	}
+}
+
+func TestResourceCacheLoadError(t *testing.T) {
+	c, loader := newTextCache()
+	if _, err := AcquireResource(c, "text", "missing.txt", loader.load); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("error = %v, want fs.ErrNotExist", err)
+	}
+	if c.Stats() != (ResourceStats{}) {
+		t.Errorf("Stats after a failed load = %+v", c.Stats())
+	}
+}
+
+func TestResourceCacheGroups(t *testing.T) {
+	c, loader := newTextCache()
+	err := c.Preload("level",
+		NewResourceRequest("text", "a.txt", loader.load),
+		NewResourceRequest("text", "b.txt", loader.load),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Preload("hud", NewResourceRequest("text", "b.txt", loader.load)); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := AcquireResource(c, "text", "a.txt", loader.load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (ResourceStats{Resources: 2, References: 4, Loads: 2, Hits: 2}); c.Stats() != want {
+		t.Errorf("Stats = %+v, want %+v", c.Stats(), want)
+	}
+
+	// b.txt is still held by hud and a.txt by the acquire above
+	c.ReleaseGroup("level")
+	if len(loader.frees) != 0 {
+		t.Fatalf("ReleaseGroup freed %v", loader.frees)
+	}
+	c.ReleaseGroup("hud")
+	c.Release(a)
+	if strings.Join(loader.frees, ",") != "b.txt,a.txt" {
+		t.Errorf("frees = %v, want [b.txt a.txt]", loader.frees)
+	}
+	c.ReleaseGroup("level")
+	if want := (ResourceStats{Loads: 2, Hits: 2, Frees: 2}); c.Stats() != want {
+		t.Errorf("Stats = %+v, want %+v", c.Stats(), want)
+	}
+}
+
+func TestResourceCachePreloadRollback(t *testing.T) {
+	c, loader := newTextCache()
+	held, err := AcquireResource(c, "text", "a.txt", loader.load)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.Preload("level",
+		NewResourceRequest("text", "a.txt", loader.load),
+		NewResourceRequest("text", "b.txt", loader.load),
+		NewResourceRequest("text", "missing.txt", loader.load),
+		NewResourceRequest("text", "c.txt", loader.load),
+	)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Preload error = %v, want fs.ErrNotExist", err)
+	}
+
+	// b.txt was loaded by the failed call and freed again, a.txt is still held
+	// and c.txt was never reached
+	if strings.Join(loader.loads, ",") != "a.txt,b.txt" || strings.Join(loader.frees, ",") != "b.txt" {
+		t.Errorf("loads = %v, frees = %v", loader.loads, loader.frees)
+	}
+	if want := (ResourceStats{Resources: 1, References: 1, Loads: 2, Hits: 1, Frees: 1}); c.Stats() != want {
+		t.Errorf("Stats = %+v, want %+v", c.Stats(), want)
+	}
+
+	// Nothing was added to the group
+	c.ReleaseGroup("level")
+	c.Release(held)
+	if strings.Join(loader.frees, ",") != "b.txt,a.txt" {
+		t.Errorf("frees = %v, want [b.txt a.txt]", loader.frees)
+	}
+}
+
+func TestResourceCacheUnknownHandle(t *testing.T) {
+	c, loader := newTextCache()
+	resource, err := AcquireResource(c, "text", "a.txt", loader.load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Release(resource)
+
+	for _, handle := range []any{resource, &textResource{name: "a.txt"}, nil} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil || !strings.Contains(r.(string), "that the resource cache doesn't hold") {
+					t.Errorf("Release(%v) recovered %v, want an unknown handle panic", handle, r)
+				}
+			}()
+			c.Release(handle)
+		}()
+	}
+}
+
+// The shaders are read from FS when they are acquired, not from the FS the cache
+// was created with.
+func TestResourceCacheShaderFS(t *testing.T) {
+	c := NewResourceCache(fstest.MapFS{})
+	if _, err := c.AcquireShader("", "", "blur.frag"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("error = %v, want fs.ErrNotExist", err)
+	}
+
+	c.FS = fstest.MapFS{"blur.frag": {Data: []byte("#version 120\nvoid main() {}\n")}}
+	c.ShaderDefines = map[string]string{"RADIUS": "2"}
+	// Shaders compile on the main thread
+	var shader *Shader
+	var err error
+	Do(func() { shader, err = c.AcquireShader("", "", "blur.frag") })
+	var compileErr *ShaderCompileError
+	switch {
+	case err == nil:
+		Do(func() { c.Release(shader) })
+	case errors.As(err, &compileErr):
+		// No OpenGL context, the source got as far as compiling
+		if want := "#version 120\n#define RADIUS 2\n"; !strings.HasPrefix(compileErr.Sources.Fragment.Code, want) {
+			t.Errorf("compiled %q, want it to start with %q", compileErr.Sources.Fragment.Code, want)
+		}
+	default:
+		t.Errorf("error = %v, want the shader to be read from the new FS", err)
+	}
+}
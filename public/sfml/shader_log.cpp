@@ -0,0 +1,45 @@
+// SFML reports shader compile errors only through sf::err(), which CSFML doesn't
+// expose. These functions redirect it around a call, so the Go side can return
+// the log in the error instead of leaving it on stderr.
+
+#include <SFML/System/Err.hpp>
+
+#include <cstdlib>
+#include <cstring>
+#include <sstream>
+#include <string>
+
+namespace
+{
+std::stringstream captured;
+std::streambuf* previous = nullptr;
+}
+
+extern "C" void sfml_capture_err(void)
+{
+    captured.str("");
+    captured.clear();
+    previous = sf::err().rdbuf(captured.rdbuf());
+}
+
+// sfml_release_err restores sf::err() and returns what was written to it since
+// sfml_capture_err. The caller must free the result, which is NULL if it can't be
+// allocated. If forward is set, the text is also written to the restored stream.
+//
+// captured and previous are shared, so the Go side only calls these on the main
+// thread.
+extern "C" char* sfml_release_err(int forward)
+{
+    sf::err().rdbuf(previous);
+    previous = nullptr;
+
+    std::string text = captured.str();
+    if (forward && !text.empty())
+        sf::err() << text << std::flush;
+
+    char* result = static_cast<char*>(std::malloc(text.size() + 1));
+    if (!result)
+        return nullptr;
+    std::memcpy(result, text.c_str(), text.size() + 1);
+    return result;
+}
@@ -0,0 +1,344 @@
+package sfml
+
+// #include <SFML/Graphics/Shader.h>
+// #include <stdlib.h>
+//
+// void sfml_capture_err(void);
+// char *sfml_release_err(int forward);
+import "C"
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// SFML compiles GLSL as is, so it has no way to share code between shaders. The
+// preprocessor below resolves #include "file" against an fs.FS and injects
+// #defines before the source reaches NewShaderFromMemory, keeping track of where
+// each line came from so compile errors can point at the original files.
+
+var (
+	glslIncludePattern = regexp.MustCompile(`^\s*#\s*include\s+"([^"]+)"\s*(//.*)?$`)
+	glslOncePattern    = regexp.MustCompile(`^\s*#\s*pragma\s+once\s*(//.*)?$`)
+	glslVersionPattern = regexp.MustCompile(`^\s*#\s*version\b`)
+	// Drivers report positions in the first source string as "0:12" or "0(12)"
+	glslLogLinePattern = regexp.MustCompile(`\b0(?::(\d+)|\((\d+)\))`)
+	// SFML starts the log of each stage that fails with this line
+	sfmlCompileFailurePattern = regexp.MustCompile(`^Failed to compile (vertex|geometry|fragment) shader:`)
+)
+
+// definesFile is the file reported for the lines holding the injected defines.
+const definesFile = "<defines>"
+
+// SourceLine is the file and line number a line of preprocessed source came
+// from.
+type SourceLine struct {
+	File string
+	Line int
+}
+
+// String formats the position as "file:line".
+func (l SourceLine) String() string {
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+// ShaderSource is GLSL source after preprocessing.
+type ShaderSource struct {
+	Code  string
+	lines []SourceLine // lines[i] is the origin of line i+1 of Code
+	files []string     // The files read, in the order they were first included
+}
+
+// Origin returns where a line of Code came from. Lines are numbered from 1, as
+// in compile errors.
+func (s *ShaderSource) Origin(line int) (SourceLine, bool) {
+	if line < 1 || line > len(s.lines) {
+		return SourceLine{}, false
+	}
+	return s.lines[line-1], true
+}
+
+// MapLog rewrites the line references of a compile log for Code, like "0:12" or
+// "0(12)", to the file and line they came from. References it can't map are
+// kept.
+func (s *ShaderSource) MapLog(log string) string {
+	return glslLogLinePattern.ReplaceAllStringFunc(log, func(match string) string {
+		groups := glslLogLinePattern.FindStringSubmatch(match)
+		line, err := strconv.Atoi(groups[1] + groups[2])
+		if err != nil {
+			return match
+		}
+		if origin, ok := s.Origin(line); ok {
+			return origin.String()
+		}
+		return match
+	})
+}
+
+// ShaderPreprocessor loads GLSL sources from FS, resolving includes and
+// injecting Defines.
+type ShaderPreprocessor struct {
+	FS fs.FS
+	// Defines are written as "#define name value" after the #version line, or at
+	// the top if there is none. An empty value defines the name alone.
+	Defines map[string]string
+}
+
+// NewShaderPreprocessor creates a preprocessor that reads from fsys.
+func NewShaderPreprocessor(fsys fs.FS, defines map[string]string) *ShaderPreprocessor {
+	return &ShaderPreprocessor{FS: fsys, Defines: defines}
+}
+
+// Preprocess reads the file name from FS and returns its source with includes
+// resolved and the defines injected. Include paths are relative to the file that
+// includes them. A file containing #pragma once is included only once, and an
+// include cycle is an error.
+func (p *ShaderPreprocessor) Preprocess(name string) (*ShaderSource, error) {
+	source := &ShaderSource{}
+	var lines []string
+	once := map[string]bool{}
+	if err := p.expand(path.Clean(name), nil, once, &lines, source); err != nil {
+		return nil, fmt.Errorf("sfml: preprocessing %s: %w", name, err)
+	}
+
+	// Defines must come after #version, which has to be the first directive.
+	// Only comments and blank lines may precede it.
+	insertAt := 0
+	inComment := false
+	for i, line := range lines {
+		var code string
+		code, inComment = skipGLSLComments(line, inComment)
+		if code == "" {
+			continue
+		}
+		if glslVersionPattern.MatchString(code) {
+			insertAt = i + 1
+		}
+		break
+	}
+	names := make([]string, 0, len(p.Defines))
+	for defineName := range p.Defines {
+		names = append(names, defineName)
+	}
+	slices.Sort(names)
+	defines := make([]string, len(names))
+	origins := make([]SourceLine, len(names))
+	for i, defineName := range names {
+		defines[i] = strings.TrimSpace("#define " + defineName + " " + p.Defines[defineName])
+		origins[i] = SourceLine{File: definesFile, Line: i + 1}
+	}
+	lines = slices.Insert(lines, insertAt, defines...)
+	source.lines = slices.Insert(source.lines, insertAt, origins...)
+
+	source.Code = strings.Join(lines, "\n") + "\n"
+	return source, nil
+}
+
+// skipGLSLComments returns what follows the comments at the start of line,
+// trimmed, and whether line ends inside a /* */ comment. inComment tells whether
+// line starts inside one.
+func skipGLSLComments(line string, inComment bool) (string, bool) {
+	for {
+		if inComment {
+			_, rest, ok := strings.Cut(line, "*/")
+			if !ok {
+				return "", true
+			}
+			line, inComment = rest, false
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "//"):
+			return "", false
+		case strings.HasPrefix(line, "/*"):
+			line, inComment = line[2:], true
+		default:
+			return line, false
+		}
+	}
+}
+
+// expand appends the lines of name to lines and their origins to source,
+// replacing includes with the lines of the included files. stack holds the files
+// being expanded, to catch cycles.
+func (p *ShaderPreprocessor) expand(name string, stack []string, once map[string]bool, lines *[]string, source *ShaderSource) error {
+	// A file with #pragma once that includes itself, directly or not, is skipped
+	// like any repeated include rather than being a cycle
+	if once[name] {
+		return nil
+	}
+	if slices.Contains(stack, name) {
+		return fmt.Errorf("include cycle: %s -> %s", strings.Join(stack, " -> "), name)
+	}
+	data, err := fs.ReadFile(p.FS, name)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(source.files, name) {
+		source.files = append(source.files, name)
+	}
+	stack = append(stack, name)
+
+	content := strings.ReplaceAll(string(data), "\r\n", "\n")
+	content = strings.TrimSuffix(content, "\n")
+	for i, line := range strings.Split(content, "\n") {
+		if glslOncePattern.MatchString(line) {
+			once[name] = true
+			continue
+		}
+		match := glslIncludePattern.FindStringSubmatch(line)
+		if match == nil {
+			*lines = append(*lines, line)
+			source.lines = append(source.lines, SourceLine{File: name, Line: i + 1})
+			continue
+		}
+
+		included := path.Join(path.Dir(name), match[1])
+		if err := p.expand(included, stack, once, lines, source); err != nil {
+			return fmt.Errorf("%s:%d: %w", name, i+1, err)
+		}
+	}
+	return nil
+}
+
+// ShaderSources holds the preprocessed sources of the stages of a shader.
+type ShaderSources struct {
+	Vertex, Geometry, Fragment *ShaderSource // nil for a skipped stage
+}
+
+// PreprocessShader preprocesses the source of each stage of a shader. An empty
+// name skips that stage.
+func (p *ShaderPreprocessor) PreprocessShader(vertexShader, geometryShader, fragmentShader string) (*ShaderSources, error) {
+	sources := &ShaderSources{}
+	for _, stage := range []struct {
+		name   string
+		source **ShaderSource
+	}{
+		{vertexShader, &sources.Vertex},
+		{geometryShader, &sources.Geometry},
+		{fragmentShader, &sources.Fragment},
+	} {
+		if stage.name == "" {
+			continue
+		}
+		source, err := p.Preprocess(stage.name)
+		if err != nil {
+			return nil, err
+		}
+		*stage.source = source
+	}
+	return sources, nil
+}
+
+// NewShader preprocesses the given files and compiles them, see
+// ShaderSources.NewShader. An empty name skips that stage.
+func (p *ShaderPreprocessor) NewShader(vertexShader, geometryShader, fragmentShader string) (*Shader, error) {
+	sources, err := p.PreprocessShader(vertexShader, geometryShader, fragmentShader)
+	if err != nil {
+		return nil, err
+	}
+	return sources.NewShader()
+}
+
+// NewShader compiles the sources like NewShaderFromMemory. If compiling fails,
+// the error is a *ShaderCompileError holding the compile log. It must be called
+// on the main thread.
+func (s *ShaderSources) NewShader() (*Shader, error) {
+	code := func(source *ShaderSource) string {
+		if source == nil {
+			return ""
+		}
+		return source.Code
+	}
+	shader, log := compileShader(code(s.Vertex), code(s.Geometry), code(s.Fragment))
+	if shader.ToC() == nil {
+		return nil, &ShaderCompileError{Sources: s, Log: s.MapLog(log)}
+	}
+	return shader, nil
+}
+
+// MapLog rewrites the line references of a compile log SFML wrote for the
+// sources, with ShaderSource.MapLog of the stage each part of the log is about.
+func (s *ShaderSources) MapLog(log string) string {
+	var source *ShaderSource
+	lines := strings.Split(log, "\n")
+	for i, line := range lines {
+		if match := sfmlCompileFailurePattern.FindStringSubmatch(line); match != nil {
+			source = map[string]*ShaderSource{"vertex": s.Vertex, "geometry": s.Geometry, "fragment": s.Fragment}[match[1]]
+			continue
+		}
+		if source != nil {
+			lines[i] = source.MapLog(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Files returns the files the sources were read from, each once.
+func (s *ShaderSources) Files() []string {
+	var files []string
+	for _, source := range []*ShaderSource{s.Vertex, s.Geometry, s.Fragment} {
+		if source == nil {
+			continue
+		}
+		for _, file := range source.files {
+			if !slices.Contains(files, file) {
+				files = append(files, file)
+			}
+		}
+	}
+	return files
+}
+
+// ShaderCompileError is returned when SFML fails to compile preprocessed
+// sources.
+type ShaderCompileError struct {
+	Sources *ShaderSources
+	// Log is what SFML reported, with the line references of each stage mapped
+	// to the original files
+	Log string
+}
+
+func (e *ShaderCompileError) Error() string {
+	log := strings.TrimSpace(e.Log)
+	if log == "" {
+		return "sfml: failed to compile shader"
+	}
+	return "sfml: failed to compile shader:\n" + log
+}
+
+// compileShader calls sfShader_createFromMemory, passing NULL for the empty
+// sources so SFML skips those stages, and returns the shader with what SFML
+// logged. The shader wraps NULL if compiling fails; otherwise the log, usually
+// empty, is also passed on to stderr as SFML would.
+//
+// Redirecting sf::err() is global, so two compiles at once would mix their logs.
+// compileShader must run on the main thread, which the sfmldebug build checks.
+func compileShader(vertexShader, geometryShader, fragmentShader string) (*Shader, string) {
+	assertMainThread()
+	var cSources [3]*C.char
+	for i, source := range []string{vertexShader, geometryShader, fragmentShader} {
+		if source != "" {
+			cSources[i] = C.CString(source)
+			defer C.free(unsafe.Pointer(cSources[i]))
+		}
+	}
+
+	C.sfml_capture_err()
+	shader := NewShaderFromC(C.sfShader_createFromMemory(cSources[0], cSources[1], cSources[2]))
+	forward := C.int(0)
+	if shader.ToC() != nil {
+		forward = 1
+	}
+	cLog := C.sfml_release_err(forward)
+	if cLog == nil {
+		panic("C.malloc failed")
+	}
+	defer C.free(unsafe.Pointer(cLog))
+	return shader, C.GoString(cLog)
+}
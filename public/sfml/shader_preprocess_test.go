@@ -0,0 +1,137 @@
+package sfml
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestPreprocessIncludes(t *testing.T) {
+	fsys := fstest.MapFS{
+		"shaders/main.frag":        {Data: []byte("#version 120\n#include \"lib/common.glsl\"\n#include \"lib/common.glsl\"\nvoid main() {}\n")},
+		"shaders/lib/common.glsl":  {Data: []byte("#pragma once\n#include \"noise.glsl\"\nfloat common;\n")},
+		"shaders/lib/noise.glsl":   {Data: []byte("#pragma once\n#include \"common.glsl\"\nfloat noise;\n")},
+		"shaders/cycle.frag":       {Data: []byte("#include \"lib/a.glsl\"\n")},
+		"shaders/lib/a.glsl":       {Data: []byte("#include \"b.glsl\"\n")},
+		"shaders/lib/b.glsl":       {Data: []byte("#include \"a.glsl\"\n")},
+		"shaders/missing.frag":     {Data: []byte("#include \"nope.glsl\"\n")},
+		"shaders/lib/windows.glsl": {Data: []byte("float a;\r\nfloat b;\r\n")},
+	}
+	p := NewShaderPreprocessor(fsys, nil)
+
+	source, err := p.Preprocess("shaders/main.frag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "#version 120\nfloat noise;\nfloat common;\nvoid main() {}\n"
+	if source.Code != want {
+		t.Errorf("Code = %q, want %q", source.Code, want)
+	}
+	origins := []SourceLine{
+		{"shaders/main.frag", 1},
+		{"shaders/lib/noise.glsl", 3},
+		{"shaders/lib/common.glsl", 3},
+		{"shaders/main.frag", 4},
+	}
+	for i, want := range origins {
+		if got, ok := source.Origin(i + 1); !ok || got != want {
+			t.Errorf("Origin(%d) = %v, %v, want %v", i+1, got, ok, want)
+		}
+	}
+	if _, ok := source.Origin(len(origins) + 1); ok {
+		t.Errorf("Origin past the end is ok")
+	}
+
+	_, err = p.Preprocess("shaders/cycle.frag")
+	if err == nil || !strings.Contains(err.Error(), "include cycle: shaders/cycle.frag -> shaders/lib/a.glsl -> shaders/lib/b.glsl -> shaders/lib/a.glsl") {
+		t.Errorf("cycle error = %v", err)
+	}
+	_, err = p.Preprocess("shaders/missing.frag")
+	if err == nil || !strings.Contains(err.Error(), "shaders/missing.frag:1") {
+		t.Errorf("missing include error = %v", err)
+	}
+
+	source, err = p.Preprocess("shaders/lib/windows.glsl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source.Code != "float a;\nfloat b;\n" {
+		t.Errorf("CRLF Code = %q", source.Code)
+	}
+}
+
+func TestPreprocessDefines(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "after version",
+			source: "#version 330\nvoid main() {}\n",
+			want:   "#version 330\n#define BLUR 4\n#define HDR\nvoid main() {}\n",
+		},
+		{
+			name:   "after comments and version",
+			source: "// Blur\n/* License\n   text */\n\n  /* a */ /* b */\n#version 330\nvoid main() {}\n",
+			want:   "// Blur\n/* License\n   text */\n\n  /* a */ /* b */\n#version 330\n#define BLUR 4\n#define HDR\nvoid main() {}\n",
+		},
+		{
+			name:   "without version",
+			source: "/* no version */\nvoid main() {}\n",
+			want:   "#define BLUR 4\n#define HDR\n/* no version */\nvoid main() {}\n",
+		},
+		{
+			name:   "version inside a comment",
+			source: "/*\n#version 330\n*/\nvoid main() {}\n",
+			want:   "#define BLUR 4\n#define HDR\n/*\n#version 330\n*/\nvoid main() {}\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fsys := fstest.MapFS{"shader.frag": {Data: []byte(test.source)}}
+			p := NewShaderPreprocessor(fsys, map[string]string{"HDR": "", "BLUR": "4"})
+			source, err := p.Preprocess("shader.frag")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if source.Code != test.want {
+				t.Errorf("Code = %q, want %q", source.Code, test.want)
+			}
+		})
+	}
+}
+
+func TestShaderSourcesMapLog(t *testing.T) {
+	fsys := fstest.MapFS{
+		"blur.vert": {Data: []byte("#version 120\nvoid main() {}\n")},
+		"blur.frag": {Data: []byte("#version 120\n#include \"lib.glsl\"\nvoid main() {}\n")},
+		"lib.glsl":  {Data: []byte("float broken\n")},
+	}
+	p := NewShaderPreprocessor(fsys, map[string]string{"RADIUS": "2"})
+	sources, err := p.PreprocessShader("blur.vert", "", "blur.frag")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log := "Failed to compile vertex shader:\n0:3(1): error: vertex\n\n" +
+		"Failed to compile fragment shader:\n0:3(1): error: syntax error\nERROR: 0:2: 'RADIUS' : redefinition\n0:9: past the end\n"
+	want := "Failed to compile vertex shader:\nblur.vert:2(1): error: vertex\n\n" +
+		"Failed to compile fragment shader:\nlib.glsl:1(1): error: syntax error\nERROR: <defines>:1: 'RADIUS' : redefinition\n0:9: past the end\n"
+	if got := sources.MapLog(log); got != want {
+		t.Errorf("MapLog = %q, want %q", got, want)
+	}
+
+	err = &ShaderCompileError{Sources: sources, Log: sources.MapLog(log)}
+	if !strings.HasPrefix(err.Error(), "sfml: failed to compile shader:\nFailed to compile vertex shader:\nblur.vert:2(1)") {
+		t.Errorf("Error = %q", err.Error())
+	}
+	if got := (&ShaderCompileError{Sources: sources}).Error(); got != "sfml: failed to compile shader" {
+		t.Errorf("Error without a log = %q", got)
+	}
+
+	if files := sources.Files(); !slices.Equal(files, []string{"blur.vert", "blur.frag", "lib.glsl"}) {
+		t.Errorf("Files = %v", files)
+	}
+}
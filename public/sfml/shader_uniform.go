@@ -0,0 +1,384 @@
+package sfml
+
+// #include <SFML/Graphics/Shader.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+)
+
+// SetUniform sets a uniform from any supported Go value, picking the typed setter
+// for its type:
+//
+//   - bool, Vector2b, Vector3b, Vector4b: bool, bvec2, bvec3, bvec4
+//   - float32, float64, time.Duration (in seconds): float
+//   - int, int32, Vector2i, Vector3i, Vector4i: int, ivec2, ivec3, ivec4
+//   - Vector2f, Vector3f, Vector4f: vec2, vec3, vec4
+//   - Color: vec4, with channels normalized to [0, 1]
+//   - GlslMat3, GlslMat4: mat3, mat4
+//   - Transform, TransformMatrix: mat4
+//   - *Texture: sampler2D
+//   - []float32, []Vector2f, []Vector3f, []Vector4f, []GlslMat3, []GlslMat4:
+//     arrays of the element type
+//
+// Matrices and transforms may also be passed as pointers. Other types return an
+// error. SFML ignores unknown names, so use a CheckedShader to catch those. A
+// CheckedShader also sets a Color declared as ivec4 with integer channels, and a
+// transform declared as mat3 as a mat3.
+func (s *Shader) SetUniform(name string, value any) error {
+	return s.setUniform(name, nil, value)
+}
+
+// setUniform sets a uniform, checking value against declared if it isn't nil.
+// The declared type also picks between the setters a type has, like ivec4 for a
+// Color or mat3 for a Transform. It calls the C setters itself, so the C copy of
+// name is made once and freed.
+func (s *Shader) setUniform(name string, declared *ShaderUniform, value any) error {
+	expect := func(glslTypes ...string) error {
+		if declared == nil || slices.Contains(glslTypes, declared.Type) {
+			return nil
+		}
+		return fmt.Errorf("sfml: uniform %q is declared as %s, not %s", name, declared.Type, strings.Join(glslTypes, " or "))
+	}
+	expectArray := func(glslType string, length int) error {
+		if err := expect(glslType); err != nil {
+			return err
+		}
+		if declared != nil && declared.Length > 0 && length > declared.Length {
+			return fmt.Errorf("sfml: uniform %q has %d elements, got %d", name, declared.Length, length)
+		}
+		return nil
+	}
+	declaredType := ""
+	if declared != nil {
+		declaredType = declared.Type
+	}
+
+	// set calls the C setter matching value; it stays nil for an empty array
+	var set func(shader *C.sfShader, cName *C.char)
+	var err error
+	switch v := value.(type) {
+	case bool:
+		err = expect("bool")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setBoolUniform(shader, cName, boolToSfBool(v))
+		}
+	case Vector2b:
+		err = expect("bvec2")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setBvec2Uniform(shader, cName, v.ToC())
+		}
+	case Vector3b:
+		err = expect("bvec3")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setBvec3Uniform(shader, cName, v.ToC())
+		}
+	case Vector4b:
+		err = expect("bvec4")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setBvec4Uniform(shader, cName, v.ToC())
+		}
+	case float32:
+		err = expect("float")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setFloatUniform(shader, cName, C.float(v))
+		}
+	case float64:
+		err = expect("float")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setFloatUniform(shader, cName, C.float(v))
+		}
+	case time.Duration:
+		err = expect("float")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setFloatUniform(shader, cName, C.float(v.Seconds()))
+		}
+	case int:
+		if err = expect("int"); err == nil && (v < math.MinInt32 || v > math.MaxInt32) {
+			err = fmt.Errorf("sfml: uniform %q value %d overflows a GLSL int", name, v)
+		}
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setIntUniform(shader, cName, C.int(v))
+		}
+	case int32:
+		err = expect("int")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setIntUniform(shader, cName, C.int(v))
+		}
+	case Vector2i:
+		err = expect("ivec2")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setIvec2Uniform(shader, cName, v.ToC())
+		}
+	case Vector3i:
+		err = expect("ivec3")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setIvec3Uniform(shader, cName, v.ToC())
+		}
+	case Vector4i:
+		err = expect("ivec4")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setIvec4Uniform(shader, cName, v.ToC())
+		}
+	case Vector2f:
+		err = expect("vec2")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setVec2Uniform(shader, cName, v.ToC())
+		}
+	case Vector3f:
+		err = expect("vec3")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setVec3Uniform(shader, cName, v.ToC())
+		}
+	case Vector4f:
+		err = expect("vec4")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setVec4Uniform(shader, cName, v.ToC())
+		}
+	case Color:
+		err = expect("vec4", "ivec4")
+		set = func(shader *C.sfShader, cName *C.char) {
+			if declaredType == "ivec4" {
+				C.sfShader_setIntColorUniform(shader, cName, v.ToC())
+			} else {
+				C.sfShader_setColorUniform(shader, cName, v.ToC())
+			}
+		}
+	case GlslMat3:
+		return s.setUniform(name, declared, &v)
+	case *GlslMat3:
+		err = expect("mat3")
+		set = func(shader *C.sfShader, cName *C.char) {
+			mat := v.ToC()
+			C.sfShader_setMat3Uniform(shader, cName, &mat)
+		}
+	case GlslMat4:
+		return s.setUniform(name, declared, &v)
+	case *GlslMat4:
+		err = expect("mat4")
+		set = func(shader *C.sfShader, cName *C.char) {
+			mat := v.ToC()
+			C.sfShader_setMat4Uniform(shader, cName, &mat)
+		}
+	case Transform:
+		return s.setUniform(name, declared, v.ToMatrix())
+	case *Transform:
+		return s.setUniform(name, declared, v.ToMatrix())
+	case TransformMatrix:
+		if err = expect("mat4", "mat3"); err == nil {
+			if declaredType == "mat3" {
+				mat := v.ToGlslMat3()
+				return s.setUniform(name, declared, &mat)
+			}
+			mat := v.ToGlslMat4()
+			return s.setUniform(name, declared, &mat)
+		}
+	case *Texture:
+		err = expect("sampler2D")
+		set = func(shader *C.sfShader, cName *C.char) {
+			C.sfShader_setTextureUniform(shader, cName, v.ToC())
+		}
+	case []float32:
+		err = expectArray("float", len(v))
+		if len(v) > 0 {
+			set = func(shader *C.sfShader, cName *C.char) {
+				C.sfShader_setFloatUniformArray(shader, cName, (*C.float)(unsafe.Pointer(&v[0])), C.size_t(len(v)))
+			}
+		}
+	case []Vector2f:
+		err = expectArray("vec2", len(v))
+		set = uniformArraySetter(v, C.sfVector2f{}, func(shader *C.sfShader, cName *C.char, ptr *C.sfVector2f, length C.size_t) {
+			C.sfShader_setVec2UniformArray(shader, cName, ptr, length)
+		})
+	case []Vector3f:
+		err = expectArray("vec3", len(v))
+		set = uniformArraySetter(v, C.sfVector3f{}, func(shader *C.sfShader, cName *C.char, ptr *C.sfVector3f, length C.size_t) {
+			C.sfShader_setVec3UniformArray(shader, cName, ptr, length)
+		})
+	case []Vector4f:
+		err = expectArray("vec4", len(v))
+		set = uniformArraySetter(v, C.sfGlslVec4{}, func(shader *C.sfShader, cName *C.char, ptr *C.sfGlslVec4, length C.size_t) {
+			C.sfShader_setVec4UniformArray(shader, cName, ptr, length)
+		})
+	case []GlslMat3:
+		err = expectArray("mat3", len(v))
+		set = uniformArraySetter(v, C.sfGlslMat3{}, func(shader *C.sfShader, cName *C.char, ptr *C.sfGlslMat3, length C.size_t) {
+			C.sfShader_setMat3UniformArray(shader, cName, ptr, length)
+		})
+	case []GlslMat4:
+		err = expectArray("mat4", len(v))
+		set = uniformArraySetter(v, C.sfGlslMat4{}, func(shader *C.sfShader, cName *C.char, ptr *C.sfGlslMat4, length C.size_t) {
+			C.sfShader_setMat4UniformArray(shader, cName, ptr, length)
+		})
+	default:
+		return fmt.Errorf("sfml: unsupported uniform type %T for %q", value, name)
+	}
+	if err != nil || set == nil {
+		return err
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	set(s.ToC(), cName)
+	return nil
+}
+
+// uniformArraySetter returns a setter passing the elements of values to set in
+// place, as the Go type G and the C type T have the same layout, or nil if values
+// is empty. cZero only carries T for the size check.
+func uniformArraySetter[G any, T any](values []G, cZero T, set func(*C.sfShader, *C.char, *T, C.size_t)) func(*C.sfShader, *C.char) {
+	var zero G
+	if unsafe.Sizeof(zero) != unsafe.Sizeof(cZero) {
+		panic("Size mismatch between Go and C types")
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return func(shader *C.sfShader, cName *C.char) {
+		set(shader, cName, (*T)(unsafe.Pointer(&values[0])), C.size_t(len(values)))
+	}
+}
+
+// ShaderUniform is a uniform declared in GLSL source.
+type ShaderUniform struct {
+	Name string
+	Type string // The GLSL type, e.g. "vec2" or "sampler2D"
+	// Length is the number of elements of an array uniform, 0 if the uniform is
+	// not an array, or -1 if the size is not a literal, e.g. a macro.
+	Length int
+}
+
+// ShaderUniforms maps uniform names to their declarations.
+type ShaderUniforms map[string]ShaderUniform
+
+var (
+	glslCommentPattern = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+	glslUniformPattern = regexp.MustCompile(`\buniform\s+([^;{}]+);`)
+	glslNamePattern    = regexp.MustCompile(`^(\w+)\s*(?:\[\s*(\w*)\s*\])?$`)
+)
+
+// glslPrecisions are the qualifiers that may come between uniform and the type.
+var glslPrecisions = []string{"lowp", "mediump", "highp"}
+
+// ParseShaderUniforms collects the uniforms declared in GLSL sources, usually
+// the vertex, geometry and fragment sources of one shader. Uniform blocks are
+// skipped. A uniform declared in several sources must have the same type in
+// each.
+func ParseShaderUniforms(sources ...string) (ShaderUniforms, error) {
+	uniforms := ShaderUniforms{}
+	for _, source := range sources {
+		source = glslCommentPattern.ReplaceAllString(source, " ")
+		for _, match := range glslUniformPattern.FindAllStringSubmatch(source, -1) {
+			fields := strings.Fields(match[1])
+			for len(fields) > 0 && slices.Contains(glslPrecisions, fields[0]) {
+				fields = fields[1:]
+			}
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("sfml: invalid uniform declaration %q", strings.TrimSpace(match[0]))
+			}
+
+			// The type may carry the array size itself, as in "float[4] weights"
+			glslType, typeLength := fields[0], 0
+			if base, size, ok := strings.Cut(glslType, "["); ok {
+				glslType, typeLength = base, parseUniformLength(strings.TrimSuffix(size, "]"))
+			}
+
+			for _, declarator := range strings.Split(strings.Join(fields[1:], " "), ",") {
+				// Drop an initializer, which GLSL allows for uniforms
+				declarator, _, _ = strings.Cut(declarator, "=")
+				parts := glslNamePattern.FindStringSubmatch(strings.TrimSpace(declarator))
+				if parts == nil {
+					return nil, fmt.Errorf("sfml: invalid uniform declaration %q", strings.TrimSpace(match[0]))
+				}
+
+				uniform := ShaderUniform{Name: parts[1], Type: glslType, Length: typeLength}
+				if strings.Contains(declarator, "[") {
+					uniform.Length = parseUniformLength(parts[2])
+				}
+				if previous, ok := uniforms[uniform.Name]; ok && previous.Type != uniform.Type {
+					return nil, fmt.Errorf("sfml: uniform %q declared as both %s and %s", uniform.Name, previous.Type, uniform.Type)
+				}
+				uniforms[uniform.Name] = uniform
+			}
+		}
+	}
+	return uniforms, nil
+}
+
+func parseUniformLength(size string) int {
+	length, err := strconv.Atoi(size)
+	if err != nil {
+		return -1
+	}
+	return length
+}
+
+// CheckedShader is a Shader that knows the uniforms declared in its source, so
+// SetUniform fails on a name or type that doesn't match instead of being ignored
+// by SFML.
+type CheckedShader struct {
+	*Shader
+	Uniforms ShaderUniforms
+}
+
+// NewCheckedShader wraps shader with the uniforms parsed from its GLSL sources.
+func NewCheckedShader(shader *Shader, sources ...string) (*CheckedShader, error) {
+	uniforms, err := ParseShaderUniforms(sources...)
+	if err != nil {
+		return nil, err
+	}
+	return &CheckedShader{Shader: shader, Uniforms: uniforms}, nil
+}
+
+// NewCheckedShaderFromMemory compiles a shader from GLSL sources and parses its
+// uniforms from the same sources. An empty source skips that stage.
+func NewCheckedShaderFromMemory(vertexShader, geometryShader, fragmentShader string) (*CheckedShader, error) {
+	uniforms, err := ParseShaderUniforms(vertexShader, geometryShader, fragmentShader)
+	if err != nil {
+		return nil, err
+	}
+	shader, log := compileShader(vertexShader, geometryShader, fragmentShader)
+	if shader.ToC() == nil {
+		return nil, fmt.Errorf("sfml: failed to compile shader:\n%s", strings.TrimSpace(log))
+	}
+	return &CheckedShader{Shader: shader, Uniforms: uniforms}, nil
+}
+
+// NewCheckedShaderFromFile reads the GLSL files of a shader, then compiles them
+// and parses their uniforms like NewCheckedShaderFromMemory. An empty filename
+// skips that stage.
+func NewCheckedShaderFromFile(vertexShaderFilename, geometryShaderFilename, fragmentShaderFilename string) (*CheckedShader, error) {
+	var sources [3]string
+	for i, filename := range []string{vertexShaderFilename, geometryShaderFilename, fragmentShaderFilename} {
+		if filename == "" {
+			continue
+		}
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == 0 {
+			return nil, fmt.Errorf("sfml: shader %s is empty", filename)
+		}
+		sources[i] = string(data)
+	}
+	return NewCheckedShaderFromMemory(sources[0], sources[1], sources[2])
+}
+
+// SetUniform sets a declared uniform like Shader.SetUniform, and returns an error
+// if name is not declared or value doesn't fit its type.
+func (c *CheckedShader) SetUniform(name string, value any) error {
+	uniform, ok := c.Uniforms[name]
+	if !ok {
+		return fmt.Errorf("sfml: shader declares no uniform %q", name)
+	}
+	return c.Shader.setUniform(name, &uniform, value)
+}
@@ -0,0 +1,74 @@
+package sfml
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseShaderUniforms(t *testing.T) {
+	vertex := `
+#version 120
+uniform mat4 view; // the camera
+uniform highp vec2 offset, scale;
+/* uniform float ignored; */
+uniform float weights[4];
+uniform float[COUNT] taps;
+uniform vec3 tint = vec3(1.0);
+uniform Lights { vec4 colors[8]; } lights;
+`
+	fragment := `
+uniform sampler2D texture;
+uniform vec2 offset;
+`
+	uniforms, err := ParseShaderUniforms(vertex, "", fragment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ShaderUniforms{
+		"view":    {Name: "view", Type: "mat4"},
+		"offset":  {Name: "offset", Type: "vec2"},
+		"scale":   {Name: "scale", Type: "vec2"},
+		"weights": {Name: "weights", Type: "float", Length: 4},
+		"taps":    {Name: "taps", Type: "float", Length: -1},
+		"tint":    {Name: "tint", Type: "vec3"},
+		"texture": {Name: "texture", Type: "sampler2D"},
+	}
+	if !reflect.DeepEqual(uniforms, want) {
+		t.Errorf("ParseShaderUniforms = %v, want %v", uniforms, want)
+	}
+}
+
+func TestParseShaderUniformsConflict(t *testing.T) {
+	_, err := ParseShaderUniforms("uniform vec2 offset;", "uniform vec3 offset;")
+	if err == nil || !strings.Contains(err.Error(), "declared as both") {
+		t.Errorf("err = %v, want a type conflict", err)
+	}
+}
+
+// The checks below fail before anything reaches C, so the shader is never used.
+func TestCheckedShaderSetUniformErrors(t *testing.T) {
+	shader := &CheckedShader{Shader: &Shader{}, Uniforms: ShaderUniforms{
+		"count":   {Name: "count", Type: "int"},
+		"weights": {Name: "weights", Type: "float", Length: 2},
+	}}
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{name: "missing", value: 1, want: "declares no uniform"},
+		{name: "count", value: float32(1), want: "declared as int"},
+		{name: "count", value: math.MaxInt32 + 1, want: "overflows"},
+		{name: "count", value: math.MinInt32 - 1, want: "overflows"},
+		{name: "count", value: "1", want: "unsupported uniform type"},
+		{name: "weights", value: []float32{1, 2, 3}, want: "has 2 elements"},
+	}
+	for _, test := range tests {
+		err := shader.SetUniform(test.name, test.value)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("SetUniform(%q, %v) = %v, want an error containing %q", test.name, test.value, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package sfml
+
+import "time"
+
+// Generated signatures use time.Duration wherever SFML uses sfTime. Time is kept
+// for code that still works with it.
+
+// NewTimeFromDuration converts d to a Time.
+func NewTimeFromDuration(d time.Duration) *Time {
+	return &Time{Microseconds: d.Microseconds()}
+}
+
+// Duration converts t to a time.Duration.
+func (t *Time) Duration() time.Duration {
+	return time.Duration(t.Microseconds) * time.Microsecond
+}
+
+// SetDurationUniform sets a float uniform to d in seconds, the usual unit of a
+// shader's time input.
+func (s *Shader) SetDurationUniform(name string, d time.Duration) {
+	s.SetFloatUniform(name, float32(d.Seconds()))
+}
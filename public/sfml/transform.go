@@ -0,0 +1,207 @@
+package sfml
+
+import (
+	"math"
+	"unsafe"
+)
+
+// TransformMatrix is a pure-Go 3x3 transform matrix with the row-major layout of
+// sfTransform:
+//
+//	| a00 a01 a02 |
+//	| a10 a11 a12 |
+//	| a20 a21 a22 |
+//
+// Its methods follow SFML's arithmetic step by step, so they give the same results
+// as the cgo-backed Transform methods without a cgo call each. Only Rotate may
+// differ in the last bit, as Go and C compute sin and cos differently. Products
+// are wrapped in float32() so the compiler can't fuse them into FMA instructions,
+// which would round differently.
+type TransformMatrix [9]float32
+
+// IdentityMatrix returns the transform that changes nothing.
+func IdentityMatrix() TransformMatrix {
+	return TransformMatrix{1, 0, 0, 0, 1, 0, 0, 0, 1}
+}
+
+// ToMatrix copies the transform into a TransformMatrix.
+func (t *Transform) ToMatrix() TransformMatrix {
+	if unsafe.Sizeof(TransformMatrix{}) != unsafe.Sizeof(t.obj) {
+		panic("Size mismatch between Go and C types")
+	}
+	return *(*TransformMatrix)(unsafe.Pointer(&t.obj))
+}
+
+// ToTransform copies the matrix into a Transform, to pass it to SFML.
+func (m TransformMatrix) ToTransform() *Transform {
+	t := &Transform{}
+	if unsafe.Sizeof(m) != unsafe.Sizeof(t.obj) {
+		panic("Size mismatch between Go and C types")
+	}
+	*(*TransformMatrix)(unsafe.Pointer(&t.obj)) = m
+	return t
+}
+
+// Combine returns m multiplied by other, so other is applied first.
+func (m TransformMatrix) Combine(other TransformMatrix) TransformMatrix {
+	a, b := m, other
+	return TransformMatrix{
+		float32(a[0]*b[0]) + float32(a[1]*b[3]) + float32(a[2]*b[6]),
+		float32(a[0]*b[1]) + float32(a[1]*b[4]) + float32(a[2]*b[7]),
+		float32(a[0]*b[2]) + float32(a[1]*b[5]) + float32(a[2]*b[8]),
+		float32(a[3]*b[0]) + float32(a[4]*b[3]) + float32(a[5]*b[6]),
+		float32(a[3]*b[1]) + float32(a[4]*b[4]) + float32(a[5]*b[7]),
+		float32(a[3]*b[2]) + float32(a[4]*b[5]) + float32(a[5]*b[8]),
+		float32(a[6]*b[0]) + float32(a[7]*b[3]) + float32(a[8]*b[6]),
+		float32(a[6]*b[1]) + float32(a[7]*b[4]) + float32(a[8]*b[7]),
+		float32(a[6]*b[2]) + float32(a[7]*b[5]) + float32(a[8]*b[8]),
+	}
+}
+
+// Translate returns m combined with a translation by (x, y).
+func (m TransformMatrix) Translate(x, y float32) TransformMatrix {
+	return m.Combine(TransformMatrix{1, 0, x, 0, 1, y, 0, 0, 1})
+}
+
+// Rotate returns m combined with a rotation by angle degrees.
+func (m TransformMatrix) Rotate(angle float32) TransformMatrix {
+	cos, sin := rotationCosSin(angle)
+	return m.Combine(TransformMatrix{cos, -sin, 0, sin, cos, 0, 0, 0, 1})
+}
+
+// RotateWithCenter returns m combined with a rotation by angle degrees around
+// (centerX, centerY).
+func (m TransformMatrix) RotateWithCenter(angle, centerX, centerY float32) TransformMatrix {
+	cos, sin := rotationCosSin(angle)
+	return m.Combine(TransformMatrix{
+		cos, -sin, float32(centerX*(1-cos)) + float32(centerY*sin),
+		sin, cos, float32(centerY*(1-cos)) - float32(centerX*sin),
+		0, 0, 1,
+	})
+}
+
+// Scale returns m combined with a scaling by (scaleX, scaleY).
+func (m TransformMatrix) Scale(scaleX, scaleY float32) TransformMatrix {
+	return m.Combine(TransformMatrix{scaleX, 0, 0, 0, scaleY, 0, 0, 0, 1})
+}
+
+// ScaleWithCenter returns m combined with a scaling by (scaleX, scaleY) around
+// (centerX, centerY).
+func (m TransformMatrix) ScaleWithCenter(scaleX, scaleY, centerX, centerY float32) TransformMatrix {
+	return m.Combine(TransformMatrix{
+		scaleX, 0, centerX * (1 - scaleX),
+		0, scaleY, centerY * (1 - scaleY),
+		0, 0, 1,
+	})
+}
+
+// Inverse returns the inverse of m, or the identity if m can't be inverted.
+func (m TransformMatrix) Inverse() TransformMatrix {
+	det := float32(m[0]*(float32(m[8]*m[4])-float32(m[7]*m[5]))) -
+		float32(m[3]*(float32(m[8]*m[1])-float32(m[7]*m[2]))) +
+		float32(m[6]*(float32(m[5]*m[1])-float32(m[4]*m[2])))
+	if det == 0 {
+		return IdentityMatrix()
+	}
+	return TransformMatrix{
+		(float32(m[8]*m[4]) - float32(m[7]*m[5])) / det,
+		-(float32(m[8]*m[1]) - float32(m[7]*m[2])) / det,
+		(float32(m[5]*m[1]) - float32(m[4]*m[2])) / det,
+		-(float32(m[8]*m[3]) - float32(m[6]*m[5])) / det,
+		(float32(m[8]*m[0]) - float32(m[6]*m[2])) / det,
+		-(float32(m[5]*m[0]) - float32(m[3]*m[2])) / det,
+		(float32(m[7]*m[3]) - float32(m[6]*m[4])) / det,
+		-(float32(m[7]*m[0]) - float32(m[6]*m[1])) / det,
+		(float32(m[4]*m[0]) - float32(m[3]*m[1])) / det,
+	}
+}
+
+// TransformPoint returns point transformed by m.
+func (m TransformMatrix) TransformPoint(point Vector2f) Vector2f {
+	return Vector2f{
+		X: float32(m[0]*point.X) + float32(m[1]*point.Y) + m[2],
+		Y: float32(m[3]*point.X) + float32(m[4]*point.Y) + m[5],
+	}
+}
+
+// TransformPoints transforms every point in place.
+func (m TransformMatrix) TransformPoints(points []Vector2f) {
+	for i, point := range points {
+		points[i] = m.TransformPoint(point)
+	}
+}
+
+// TransformRect returns the bounding rect of rect transformed by m.
+func (m TransformMatrix) TransformRect(rect FloatRect) FloatRect {
+	right, bottom := rect.Left+rect.Width, rect.Top+rect.Height
+	points := [4]Vector2f{
+		m.TransformPoint(Vector2f{X: rect.Left, Y: rect.Top}),
+		m.TransformPoint(Vector2f{X: rect.Left, Y: bottom}),
+		m.TransformPoint(Vector2f{X: right, Y: rect.Top}),
+		m.TransformPoint(Vector2f{X: right, Y: bottom}),
+	}
+
+	left, top := points[0].X, points[0].Y
+	right, bottom = points[0].X, points[0].Y
+	for _, point := range points[1:] {
+		left, right = min(left, point.X), max(right, point.X)
+		top, bottom = min(top, point.Y), max(bottom, point.Y)
+	}
+	return FloatRect{Left: left, Top: top, Width: right - left, Height: bottom - top}
+}
+
+// Decompose splits m into the position, rotation in degrees and scale that
+// produce it when applied as scale, then rotate, then translate. It assumes m has
+// no skew, which holds for the transforms of Transformable and the shapes. A
+// mirrored transform is reported as a negative Y scale.
+//
+// The position is the translation of m, which is the position of a Transformable
+// only if its origin is (0, 0). Use DecomposeWithOrigin for one with an origin.
+func (m TransformMatrix) Decompose() (position Vector2f, rotation float32, scale Vector2f) {
+	return m.DecomposeWithOrigin(Vector2f{})
+}
+
+// DecomposeWithOrigin is like Decompose for the transform of a Transformable
+// whose origin is origin, and returns the position it was given. The origin is
+// scaled and rotated before the translation is applied, so it can't be recovered
+// from m alone.
+func (m TransformMatrix) DecomposeWithOrigin(origin Vector2f) (position Vector2f, rotation float32, scale Vector2f) {
+	position = m.TransformPoint(origin)
+	scale = Vector2f{
+		X: float32(math.Hypot(float64(m[0]), float64(m[3]))),
+		Y: float32(math.Hypot(float64(m[1]), float64(m[4]))),
+	}
+	if float32(m[0]*m[4])-float32(m[1]*m[3]) < 0 {
+		scale.Y = -scale.Y
+	}
+	rotation = float32(math.Atan2(float64(m[3]), float64(m[0])) * 180 / math.Pi)
+	if rotation < 0 {
+		rotation += 360
+	}
+	return position, rotation, scale
+}
+
+// TransformPoints transforms every point in place in pure Go, which is much
+// faster than calling TransformPoint for each.
+func (t *Transform) TransformPoints(points []Vector2f) {
+	t.ToMatrix().TransformPoints(points)
+}
+
+// Decompose splits the transform into position, rotation and scale, see
+// TransformMatrix.Decompose.
+func (t *Transform) Decompose() (position Vector2f, rotation float32, scale Vector2f) {
+	return t.ToMatrix().Decompose()
+}
+
+// DecomposeWithOrigin splits the transform of a Transformable with the given
+// origin into position, rotation and scale, see TransformMatrix.DecomposeWithOrigin.
+func (t *Transform) DecomposeWithOrigin(origin Vector2f) (position Vector2f, rotation float32, scale Vector2f) {
+	return t.ToMatrix().DecomposeWithOrigin(origin)
+}
+
+// rotationCosSin returns the cosine and sine of angle degrees, converted to
+// radians with SFML's constant.
+func rotationCosSin(angle float32) (float32, float32) {
+	rad := float32(angle*3.141592654) / 180
+	return float32(math.Cos(float64(rad))), float32(math.Sin(float64(rad)))
+}
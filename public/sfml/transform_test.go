@@ -0,0 +1,125 @@
+package sfml
+
+import (
+	"math"
+	"testing"
+)
+
+// closeMatrix reports whether a and b match up to the last bits, which Rotate
+// may round differently than SFML.
+func closeMatrix(a, b TransformMatrix) bool {
+	for i := range a {
+		if !closeFloat(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func closeFloat(a, b float32) bool {
+	return math.Abs(float64(a-b)) <= 1e-4*max(1, math.Abs(float64(a)), math.Abs(float64(b)))
+}
+
+func closeVector(a, b Vector2f) bool {
+	return closeFloat(a.X, b.X) && closeFloat(a.Y, b.Y)
+}
+
+// transformSteps apply the same operation to a Transform and a TransformMatrix.
+var transformSteps = []struct {
+	name string
+	sfml func(*Transform)
+	pure func(TransformMatrix) TransformMatrix
+}{
+	{"translate", func(t *Transform) { t.Translate(10, -4.5) }, func(m TransformMatrix) TransformMatrix { return m.Translate(10, -4.5) }},
+	{"rotate", func(t *Transform) { t.Rotate(33) }, func(m TransformMatrix) TransformMatrix { return m.Rotate(33) }},
+	{"rotate with center", func(t *Transform) { t.RotateWithCenter(-120, 3, 7) }, func(m TransformMatrix) TransformMatrix { return m.RotateWithCenter(-120, 3, 7) }},
+	{"scale", func(t *Transform) { t.Scale(2, 0.5) }, func(m TransformMatrix) TransformMatrix { return m.Scale(2, 0.5) }},
+	{"scale with center", func(t *Transform) { t.ScaleWithCenter(-1, 3, 8, 2) }, func(m TransformMatrix) TransformMatrix { return m.ScaleWithCenter(-1, 3, 8, 2) }},
+	{"combine", func(t *Transform) { t.Combine(TransformFromMatrix(1, 2, 3, 4, 5, 6, 0, 0, 1)) }, func(m TransformMatrix) TransformMatrix {
+		return m.Combine(TransformMatrix{1, 2, 3, 4, 5, 6, 0, 0, 1})
+	}},
+}
+
+func TestTransformMatrixMatchesSFML(t *testing.T) {
+	transform := TransformFromMatrix(1, 0, 0, 0, 1, 0, 0, 0, 1)
+	matrix := IdentityMatrix()
+	for _, step := range transformSteps {
+		step.sfml(transform)
+		matrix = step.pure(matrix)
+		if got := transform.ToMatrix(); !closeMatrix(matrix, got) {
+			t.Fatalf("after %s: Go %v, SFML %v", step.name, matrix, got)
+		}
+	}
+
+	if got, want := matrix.Inverse(), transform.Inverse().ToMatrix(); !closeMatrix(got, want) {
+		t.Errorf("Inverse() = %v, SFML %v", got, want)
+	}
+	if got := matrix.Combine(matrix.Inverse()); !closeMatrix(got, IdentityMatrix()) {
+		t.Errorf("m * Inverse() = %v, want identity", got)
+	}
+	singular := TransformMatrix{1, 2, 0, 2, 4, 0, 0, 0, 1}
+	if got := singular.Inverse(); got != IdentityMatrix() {
+		t.Errorf("Inverse() of a singular matrix = %v, want identity", got)
+	}
+
+	point := Vector2f{X: 3, Y: -2}
+	if got, want := matrix.TransformPoint(point), *transform.TransformPoint(point); !closeVector(got, want) {
+		t.Errorf("TransformPoint() = %v, SFML %v", got, want)
+	}
+	points := []Vector2f{point, {X: -1, Y: 5}}
+	transform.TransformPoints(points)
+	for i, p := range []Vector2f{point, {X: -1, Y: 5}} {
+		if want := *transform.TransformPoint(p); !closeVector(points[i], want) {
+			t.Errorf("TransformPoints()[%d] = %v, SFML %v", i, points[i], want)
+		}
+	}
+
+	rect := FloatRect{Left: -2, Top: 1, Width: 4, Height: 3}
+	got, want := matrix.TransformRect(rect), *transform.TransformRect(rect)
+	if !closeFloat(got.Left, want.Left) || !closeFloat(got.Top, want.Top) || !closeFloat(got.Width, want.Width) || !closeFloat(got.Height, want.Height) {
+		t.Errorf("TransformRect() = %v, SFML %v", got, want)
+	}
+
+	if back := matrix.ToTransform().ToMatrix(); back != matrix {
+		t.Errorf("ToTransform().ToMatrix() = %v, want %v", back, matrix)
+	}
+}
+
+func TestDecompose(t *testing.T) {
+	tests := []struct {
+		name     string
+		position Vector2f
+		rotation float32
+		scale    Vector2f
+		origin   Vector2f
+	}{
+		{name: "identity", scale: Vector2f{X: 1, Y: 1}},
+		{name: "translated", position: Vector2f{X: 12, Y: -3}, scale: Vector2f{X: 1, Y: 1}},
+		{name: "rotated and scaled", position: Vector2f{X: 5, Y: 6}, rotation: 75, scale: Vector2f{X: 2, Y: 0.5}},
+		{name: "rotation past 180", rotation: 290, scale: Vector2f{X: 1, Y: 3}},
+		{name: "mirrored", position: Vector2f{X: 1, Y: 1}, rotation: 30, scale: Vector2f{X: 1, Y: -2}},
+		{name: "with origin", position: Vector2f{X: 100, Y: 50}, rotation: 45, scale: Vector2f{X: 2, Y: 2}, origin: Vector2f{X: 16, Y: 8}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transformable := NewTransformable()
+			defer transformable.Free()
+			transformable.SetPosition(tt.position)
+			transformable.SetRotation(tt.rotation)
+			transformable.SetScale(tt.scale)
+			transformable.SetOrigin(tt.origin)
+			transform := transformable.Transform()
+
+			position, rotation, scale := transform.DecomposeWithOrigin(tt.origin)
+			if !closeVector(position, tt.position) || !closeFloat(rotation, tt.rotation) || !closeVector(scale, tt.scale) {
+				t.Errorf("DecomposeWithOrigin() = %v, %v, %v, want %v, %v, %v", position, rotation, scale, tt.position, tt.rotation, tt.scale)
+			}
+
+			// Without the origin, the position is the raw translation
+			position, _, _ = transform.Decompose()
+			if m := transform.ToMatrix(); position != (Vector2f{X: m[2], Y: m[5]}) {
+				t.Errorf("Decompose() position = %v, want the translation (%v, %v)", position, m[2], m[5])
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package sfml
+
+// #include <SFML/Graphics/VertexArray.h>
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+// sfVertexArray_getVertex returns a pointer into the array storage, which the
+// generator cannot express, so the accessors below are written by hand.
+
+// Vertex returns a pointer to the vertex at index. Writes through the pointer
+// modify the array in place. The pointer is invalidated by Append, Resize, Clear
+// and Free.
+func (v *VertexArray) Vertex(index uint64) *Vertex {
+	v.checkIndex(index)
+	return (*Vertex)(unsafe.Pointer(C.sfVertexArray_getVertex(v.ToC(), C.size_t(index))))
+}
+
+// SetVertex overwrites the vertex at index.
+func (v *VertexArray) SetVertex(index uint64, vertex Vertex) {
+	v.checkIndex(index)
+	*C.sfVertexArray_getVertex(v.ToC(), C.size_t(index)) = vertex.ToC()
+}
+
+// Vertices returns a slice backed directly by the array storage, so modifying
+// an element modifies the array. The slice is invalidated by Append, Resize,
+// Clear and Free; call Vertices again after any of those.
+func (v *VertexArray) Vertices() []Vertex {
+	if unsafe.Sizeof(Vertex{}) != unsafe.Sizeof(C.sfVertex{}) {
+		panic("Size mismatch between Go and C types")
+	}
+
+	count := v.VertexCount()
+	if count == 0 {
+		return nil
+	}
+	return vertexSlice(unsafe.Pointer(C.sfVertexArray_getVertex(v.ToC(), 0)), count)
+}
+
+func (v *VertexArray) checkIndex(index uint64) {
+	checkVertexIndex(index, v.VertexCount())
+}
+
+// vertexSlice views count vertices starting at ptr as a slice.
+func vertexSlice(ptr unsafe.Pointer, count uint64) []Vertex {
+	if ptr == nil || count == 0 {
+		return nil
+	}
+	return unsafe.Slice((*Vertex)(ptr), count)
+}
+
+func checkVertexIndex(index uint64, count uint64) {
+	if index >= count {
+		panic(fmt.Sprintf("sfml: vertex index %d out of range [0:%d]", index, count))
+	}
+}
@@ -0,0 +1,57 @@
+package sfml
+
+import (
+	"fmt"
+	"testing"
+	"unsafe"
+)
+
+func TestVertexSlice(t *testing.T) {
+	storage := []Vertex{
+		{Position: Vector2f{X: 1, Y: 2}},
+		{Position: Vector2f{X: 3, Y: 4}},
+		{Position: Vector2f{X: 5, Y: 6}},
+	}
+
+	vertices := vertexSlice(unsafe.Pointer(&storage[0]), uint64(len(storage)))
+	if len(vertices) != len(storage) {
+		t.Fatalf("len = %d, want %d", len(vertices), len(storage))
+	}
+	vertices[1].Position = Vector2f{X: 7, Y: 8}
+	if storage[1].Position != (Vector2f{X: 7, Y: 8}) {
+		t.Errorf("write through the slice didn't reach the storage: %v", storage[1].Position)
+	}
+	if &vertices[2] != &storage[2] {
+		t.Errorf("slice doesn't alias the storage")
+	}
+
+	if got := vertexSlice(nil, 3); got != nil {
+		t.Errorf("vertexSlice(nil, 3) = %v, want nil", got)
+	}
+	if got := vertexSlice(unsafe.Pointer(&storage[0]), 0); got != nil {
+		t.Errorf("vertexSlice(ptr, 0) = %v, want nil", got)
+	}
+}
+
+func TestCheckVertexIndex(t *testing.T) {
+	tests := []struct {
+		index, count uint64
+		wantPanic    bool
+	}{
+		{index: 0, count: 1},
+		{index: 4, count: 5},
+		{index: 5, count: 5, wantPanic: true},
+		{index: 0, count: 0, wantPanic: true},
+		{index: 1 << 40, count: 3, wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d of %d", tt.index, tt.count), func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("panic = %v, want panic %v", r, tt.wantPanic)
+				}
+			}()
+			checkVertexIndex(tt.index, tt.count)
+		})
+	}
+}
@@ -51,12 +51,15 @@ go run gen_templates.go
 
 # Move to public directory
 echo "📁 Moving generated files to public directory..."
-rm -f "$PUBLIC_DIR/sfml/go_types.go" "$PUBLIC_DIR/sfml/go_functions.go" "$PUBLIC_DIR/sfml/go_addon_vector.go"
+rm -f "$PUBLIC_DIR/sfml/go_types.go" "$PUBLIC_DIR/sfml/go_functions.go" "$PUBLIC_DIR/sfml/go_addon_vector.go" "$PUBLIC_DIR/sfml/go_addon_color.go" "$PUBLIC_DIR/sfml/go_addon_rect.go" "$PUBLIC_DIR/sfml/go_addon_matrix.go"
 mkdir -p "$PUBLIC_DIR/sfml"
 
 mv "$GEN_DIR/go_types.go" "$PUBLIC_DIR/sfml/go_types.go"
 mv "$GEN_DIR/go_functions.go" "$PUBLIC_DIR/sfml/go_functions.go"
 mv "$GEN_DIR/go_addon_vector.go" "$PUBLIC_DIR/sfml/go_addon_vector.go"
+mv "$GEN_DIR/go_addon_color.go" "$PUBLIC_DIR/sfml/go_addon_color.go"
+mv "$GEN_DIR/go_addon_rect.go" "$PUBLIC_DIR/sfml/go_addon_rect.go"
+mv "$GEN_DIR/go_addon_matrix.go" "$PUBLIC_DIR/sfml/go_addon_matrix.go"
 
 echo "✅ Done. Output in $PUBLIC_DIR/sfml/"
 
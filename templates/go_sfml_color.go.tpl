@@ -0,0 +1,361 @@
+{{ define "go_sfml_color.go.tpl" }}
+// ------------------- Color Palette -------------------
+
+var (
+	{{- range . }}
+	Color{{.Name}} = Color{R: {{.R}}, G: {{.G}}, B: {{.B}}, A: {{.A}}}
+	{{- end }}
+)
+
+// ColorPalette maps the names of the predefined colors to their values.
+var ColorPalette = map[string]Color{
+	{{- range . }}
+	"{{.Name}}": Color{{.Name}},
+	{{- end }}
+}
+
+/*
+ColorByName looks up a color of the palette, ignoring case.
+
+Params:
+  - name: the palette name, e.g. "DarkGray" or "darkgray".
+
+Returns:
+  - The color, and false if the palette has no such color.
+*/
+func ColorByName(name string) (Color, bool) {
+	for paletteName, c := range ColorPalette {
+		if strings.EqualFold(paletteName, name) {
+			return c, true
+		}
+	}
+	return Color{}, false
+}
+
+// ------------------- Color Methods -------------------
+
+var _ color.Color = Color{}
+
+/*
+RGBA implements image/color.Color. Color is not premultiplied, so the result is
+computed like for color.NRGBA.
+
+Returns:
+  - The alpha-premultiplied red, green, blue and alpha values in [0, 0xffff].
+*/
+func (c Color) RGBA() (r, g, b, a uint32) {
+	return color.NRGBA{R: c.R, G: c.G, B: c.B, A: c.A}.RGBA()
+}
+
+// ColorModel converts any image/color.Color to a Color.
+var ColorModel color.Model = color.ModelFunc(func(c color.Color) color.Color {
+	return NewColorFromGoColor(c)
+})
+
+/*
+NewColorFromGoColor converts an image/color.Color to a Color.
+
+Params:
+  - c: the color to convert.
+
+Returns:
+  - The non-premultiplied Color.
+*/
+func NewColorFromGoColor(c color.Color) Color {
+	switch sc := c.(type) {
+	case Color:
+		return sc
+	case *Color:
+		return *sc
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return Color{R: n.R, G: n.G, B: n.B, A: n.A}
+}
+
+/*
+ColorFromHsv creates an opaque color from hue, saturation and value.
+
+Params:
+  - h: hue in degrees, wrapped into [0, 360).
+  - s: saturation in [0, 1].
+  - v: value in [0, 1].
+
+Returns:
+  - A Color with alpha 255.
+*/
+func ColorFromHsv(h, s, v float64) Color {
+	chroma := v * s
+	r, g, b := hueToRgb(h, chroma)
+	m := v - chroma
+	return Color{R: floatToChannel(r + m), G: floatToChannel(g + m), B: floatToChannel(b + m), A: 255}
+}
+
+/*
+Hsv returns the hue, saturation and value of the color. Alpha is ignored.
+
+Returns:
+  - Hue in degrees in [0, 360), saturation and value in [0, 1].
+*/
+func (c Color) Hsv() (h, s, v float64) {
+	r, g, b := channelToFloat(c.R), channelToFloat(c.G), channelToFloat(c.B)
+	maxC, minC := max(r, g, b), min(r, g, b)
+	h = rgbToHue(r, g, b, maxC, maxC-minC)
+	if maxC != 0 {
+		s = (maxC - minC) / maxC
+	}
+	return h, s, maxC
+}
+
+/*
+ColorFromHsl creates an opaque color from hue, saturation and lightness.
+
+Params:
+  - h: hue in degrees, wrapped into [0, 360).
+  - s: saturation in [0, 1].
+  - l: lightness in [0, 1].
+
+Returns:
+  - A Color with alpha 255.
+*/
+func ColorFromHsl(h, s, l float64) Color {
+	chroma := (1 - math.Abs(2*l-1)) * s
+	r, g, b := hueToRgb(h, chroma)
+	m := l - chroma/2
+	return Color{R: floatToChannel(r + m), G: floatToChannel(g + m), B: floatToChannel(b + m), A: 255}
+}
+
+/*
+Hsl returns the hue, saturation and lightness of the color. Alpha is ignored.
+
+Returns:
+  - Hue in degrees in [0, 360), saturation and lightness in [0, 1].
+*/
+func (c Color) Hsl() (h, s, l float64) {
+	r, g, b := channelToFloat(c.R), channelToFloat(c.G), channelToFloat(c.B)
+	maxC, minC := max(r, g, b), min(r, g, b)
+	delta := maxC - minC
+	h = rgbToHue(r, g, b, maxC, delta)
+	l = (maxC + minC) / 2
+	if delta != 0 {
+		s = delta / (1 - math.Abs(2*l-1))
+	}
+	return h, s, l
+}
+
+/*
+ColorFromHex parses a color written as "#rrggbb" or "#rrggbbaa". The "#" is
+optional.
+
+Params:
+  - hex: the hexadecimal color.
+
+Returns:
+  - The Color, with alpha 255 if hex has no alpha digits, or an error.
+*/
+func ColorFromHex(hex string) (Color, error) {
+	digits := strings.TrimPrefix(hex, "#")
+	if len(digits) != 6 && len(digits) != 8 {
+		return Color{}, fmt.Errorf("sfml: invalid hex color %q", hex)
+	}
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("sfml: invalid hex color %q", hex)
+	}
+	if len(digits) == 6 {
+		value = value<<8 | 0xff
+	}
+	return Color{R: uint8(value >> 24), G: uint8(value >> 16), B: uint8(value >> 8), A: uint8(value)}, nil
+}
+
+/*
+Hex formats the color as "#rrggbb", or "#rrggbbaa" if it is not opaque.
+*/
+func (c Color) Hex() string {
+	if c.A == 255 {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+/*
+SrgbToLinear converts an sRGB-encoded channel to linear light.
+
+Params:
+  - v: the sRGB channel in [0, 1].
+
+Returns:
+  - The linear channel in [0, 1].
+*/
+func SrgbToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+/*
+LinearToSrgb converts a linear channel to sRGB encoding.
+
+Params:
+  - v: the linear channel in [0, 1].
+
+Returns:
+  - The sRGB channel in [0, 1].
+*/
+func LinearToSrgb(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+/*
+ToLinear converts the color from sRGB to linear light, for example before
+blending into an sRGB texture created with NewTextureSrgbFromFile. Alpha is
+already linear and is kept.
+
+Returns:
+  - The Color with linear red, green and blue.
+*/
+func (c Color) ToLinear() Color {
+	return Color{
+		R: floatToChannel(SrgbToLinear(channelToFloat(c.R))),
+		G: floatToChannel(SrgbToLinear(channelToFloat(c.G))),
+		B: floatToChannel(SrgbToLinear(channelToFloat(c.B))),
+		A: c.A,
+	}
+}
+
+/*
+ToSrgb converts the color from linear light to sRGB. Alpha is kept.
+
+Returns:
+  - The Color with sRGB-encoded red, green and blue.
+*/
+func (c Color) ToSrgb() Color {
+	return Color{
+		R: floatToChannel(LinearToSrgb(channelToFloat(c.R))),
+		G: floatToChannel(LinearToSrgb(channelToFloat(c.G))),
+		B: floatToChannel(LinearToSrgb(channelToFloat(c.B))),
+		A: c.A,
+	}
+}
+
+/*
+Lerp performs linear interpolation toward another color, alpha included.
+
+Params:
+  - other: target color.
+  - t: interpolation factor in [0, 1].
+
+Returns:
+  - Interpolated color between this and other.
+*/
+func (c Color) Lerp(other Color, t float32) Color {
+	lerp := func(a, b uint8) uint8 {
+		return floatToChannel(channelToFloat(a) + (channelToFloat(b)-channelToFloat(a))*float64(t))
+	}
+	return Color{
+		R: lerp(c.R, other.R),
+		G: lerp(c.G, other.G),
+		B: lerp(c.B, other.B),
+		A: lerp(c.A, other.A),
+	}
+}
+
+/*
+Premultiply multiplies red, green and blue by alpha, for use with a blend mode
+that expects premultiplied colors.
+
+Returns:
+  - The premultiplied Color.
+*/
+func (c Color) Premultiply() Color {
+	a := uint32(c.A)
+	return Color{
+		R: uint8((uint32(c.R)*a + 127) / 255),
+		G: uint8((uint32(c.G)*a + 127) / 255),
+		B: uint8((uint32(c.B)*a + 127) / 255),
+		A: c.A,
+	}
+}
+
+/*
+Unpremultiply divides red, green and blue by alpha, undoing Premultiply up to
+rounding.
+
+Returns:
+  - The non-premultiplied Color, or a transparent black if alpha is 0.
+*/
+func (c Color) Unpremultiply() Color {
+	if c.A == 0 {
+		return Color{}
+	}
+	a := uint32(c.A)
+	return Color{
+		R: uint8(min(255, (uint32(c.R)*255+a/2)/a)),
+		G: uint8(min(255, (uint32(c.G)*255+a/2)/a)),
+		B: uint8(min(255, (uint32(c.B)*255+a/2)/a)),
+		A: c.A,
+	}
+}
+
+/*
+String returns a formatted string representation of the color.
+*/
+func (c Color) String() string {
+	return fmt.Sprintf("Color(R: %d, G: %d, B: %d, A: %d)", c.R, c.G, c.B, c.A)
+}
+
+// hueToRgb returns the red, green and blue of a hue with the given chroma, before
+// the lightness offset is added.
+func hueToRgb(h, chroma float64) (r, g, b float64) {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	switch {
+	case h < 60:
+		return chroma, x, 0
+	case h < 120:
+		return x, chroma, 0
+	case h < 180:
+		return 0, chroma, x
+	case h < 240:
+		return 0, x, chroma
+	case h < 300:
+		return x, 0, chroma
+	default:
+		return chroma, 0, x
+	}
+}
+
+// rgbToHue returns the hue in degrees of a color with the given largest channel
+// and chroma.
+func rgbToHue(r, g, b, maxC, delta float64) float64 {
+	var h float64
+	switch {
+	case delta == 0:
+		return 0
+	case maxC == r:
+		h = 60 * math.Mod((g-b)/delta, 6)
+	case maxC == g:
+		h = 60 * ((b-r)/delta + 2)
+	default:
+		h = 60 * ((r-g)/delta + 4)
+	}
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+func channelToFloat(v uint8) float64 {
+	return float64(v) / 255
+}
+
+func floatToChannel(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+}
+{{ end }}
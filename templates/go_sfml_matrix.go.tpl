@@ -0,0 +1,217 @@
+{{ define "go_sfml_matrix.go.tpl" }}
+{{- $Name := .Name -}}
+{{- $Size := .Size -}}
+
+// ------------------- {{$Name}} Methods -------------------
+
+/*
+{{$Name}}Identity returns the identity matrix.
+*/
+func {{$Name}}Identity() {{$Name}} {
+	var m {{$Name}}
+	for i := 0; i < {{$Size}}; i++ {
+		m.Array[i*{{$Size}}+i] = 1
+	}
+	return m
+}
+
+/*
+At returns an element of the matrix.
+
+Params:
+  - row: the row, in [0, {{$Size}}).
+  - col: the column, in [0, {{$Size}}).
+
+Returns:
+  - The element at (row, col).
+*/
+func (m {{$Name}}) At(row, col int) float32 {
+	return m.Array[col*{{$Size}}+row]
+}
+
+/*
+Set changes an element of the matrix.
+
+Params:
+  - row: the row, in [0, {{$Size}}).
+  - col: the column, in [0, {{$Size}}).
+  - value: the new element.
+*/
+func (m *{{$Name}}) Set(row, col int, value float32) {
+	m.Array[col*{{$Size}}+row] = value
+}
+
+/*
+Multiply returns the product of this matrix and another, so other is applied
+first when transforming a vector, like m * other in GLSL.
+
+Params:
+  - other: the right-hand matrix.
+
+Returns:
+  - A new {{$Name}}.
+*/
+func (m {{$Name}}) Multiply(other {{$Name}}) {{$Name}} {
+	var res {{$Name}}
+	for col := 0; col < {{$Size}}; col++ {
+		for row := 0; row < {{$Size}}; row++ {
+			var sum float32
+			for k := 0; k < {{$Size}}; k++ {
+				sum += m.Array[k*{{$Size}}+row] * other.Array[col*{{$Size}}+k]
+			}
+			res.Array[col*{{$Size}}+row] = sum
+		}
+	}
+	return res
+}
+
+/*
+Transpose swaps the rows and columns of the matrix.
+
+Returns:
+  - A new, transposed {{$Name}}.
+*/
+func (m {{$Name}}) Transpose() {{$Name}} {
+	var res {{$Name}}
+	for col := 0; col < {{$Size}}; col++ {
+		for row := 0; row < {{$Size}}; row++ {
+			res.Array[row*{{$Size}}+col] = m.Array[col*{{$Size}}+row]
+		}
+	}
+	return res
+}
+
+/*
+Inverse computes the inverse of the matrix by Gauss-Jordan elimination, in
+float64 to limit rounding errors.
+
+Returns:
+  - The inverse, and false (with the identity) if the matrix can't be inverted.
+*/
+func (m {{$Name}}) Inverse() ({{$Name}}, bool) {
+	var a, inv [{{$Size}}][{{$Size}}]float64
+	for row := 0; row < {{$Size}}; row++ {
+		for col := 0; col < {{$Size}}; col++ {
+			a[row][col] = float64(m.At(row, col))
+		}
+		inv[row][row] = 1
+	}
+
+	for col := 0; col < {{$Size}}; col++ {
+		// Use the row with the largest element as pivot, for stability
+		pivot := col
+		for row := col + 1; row < {{$Size}}; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if a[pivot][col] == 0 {
+			return {{$Name}}Identity(), false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		inv[col], inv[pivot] = inv[pivot], inv[col]
+
+		scale := a[col][col]
+		for k := 0; k < {{$Size}}; k++ {
+			a[col][k] /= scale
+			inv[col][k] /= scale
+		}
+		for row := 0; row < {{$Size}}; row++ {
+			if row == col || a[row][col] == 0 {
+				continue
+			}
+			factor := a[row][col]
+			for k := 0; k < {{$Size}}; k++ {
+				a[row][k] -= factor * a[col][k]
+				inv[row][k] -= factor * inv[col][k]
+			}
+		}
+	}
+
+	var res {{$Name}}
+	for row := 0; row < {{$Size}}; row++ {
+		for col := 0; col < {{$Size}}; col++ {
+			res.Set(row, col, float32(inv[row][col]))
+		}
+	}
+	return res, true
+}
+
+{{ if eq $Size 4 -}}
+/*
+{{$Name}}Orthographic creates an orthographic projection, like glOrtho.
+
+Params:
+  - left, right: the horizontal clipping planes.
+  - bottom, top: the vertical clipping planes.
+  - near, far: the distances to the depth clipping planes.
+
+Returns:
+  - A new {{$Name}} mapping the box to normalized device coordinates.
+*/
+func {{$Name}}Orthographic(left, right, bottom, top, near, far float32) {{$Name}} {
+	var m {{$Name}}
+	m.Array[0] = 2 / (right - left)
+	m.Array[5] = 2 / (top - bottom)
+	m.Array[10] = -2 / (far - near)
+	m.Array[12] = -(right + left) / (right - left)
+	m.Array[13] = -(top + bottom) / (top - bottom)
+	m.Array[14] = -(far + near) / (far - near)
+	m.Array[15] = 1
+	return m
+}
+
+/*
+{{$Name}}Perspective creates a perspective projection, like gluPerspective.
+
+Params:
+  - fovY: the vertical field of view in degrees.
+  - aspect: the width of the viewport divided by its height.
+  - near, far: the distances to the depth clipping planes, both positive.
+
+Returns:
+  - A new {{$Name}} mapping the frustum to normalized device coordinates.
+*/
+func {{$Name}}Perspective(fovY, aspect, near, far float32) {{$Name}} {
+	f := float32(1 / math.Tan(float64(fovY)*math.Pi/360))
+	var m {{$Name}}
+	m.Array[0] = f / aspect
+	m.Array[5] = f
+	m.Array[10] = (far + near) / (near - far)
+	m.Array[11] = -1
+	m.Array[14] = 2 * far * near / (near - far)
+	return m
+}
+
+{{ end -}}
+/*
+To{{$Name}} converts the transform matrix to a {{$Name}}, with the same layout
+SFML uses when passing a Transform to a {{ if eq $Size 3 }}mat3{{ else }}mat4{{ end }} uniform.
+
+Returns:
+  - A new {{$Name}}.
+*/
+func (t TransformMatrix) To{{$Name}}() {{$Name}} {
+	{{ if eq $Size 3 -}}
+	return {{$Name}}{Array: [9]float32{
+		t[0], t[3], t[6],
+		t[1], t[4], t[7],
+		t[2], t[5], t[8],
+	}}
+	{{- else -}}
+	return {{$Name}}{Array: [16]float32{
+		t[0], t[3], 0, t[6],
+		t[1], t[4], 0, t[7],
+		0, 0, 1, 0,
+		t[2], t[5], 0, t[8],
+	}}
+	{{- end }}
+}
+
+/*
+To{{$Name}} converts the transform to a {{$Name}}, see TransformMatrix.To{{$Name}}.
+*/
+func (t *Transform) To{{$Name}}() {{$Name}} {
+	return t.ToMatrix().To{{$Name}}()
+}
+{{- end }}
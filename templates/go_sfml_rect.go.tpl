@@ -0,0 +1,228 @@
+{{ define "go_sfml_rect.go.tpl" }}
+{{- $TypeName := .TypeName -}}
+{{- $Name := .Name -}}
+{{- $VectorName := .VectorName -}}
+{{- $OtherName := .OtherName -}}
+{{- $HasFloat := .HasFloat -}}
+
+// ------------------- {{$Name}} Methods -------------------
+
+/*
+edges returns the left, top, right and bottom edges of the rect, with a negative
+width or height flipping the edges like SFML does.
+*/
+func (r {{$Name}}) edges() (left, top, right, bottom {{$TypeName}}) {
+	left, right = min(r.Left, r.Left+r.Width), max(r.Left, r.Left+r.Width)
+	top, bottom = min(r.Top, r.Top+r.Height), max(r.Top, r.Top+r.Height)
+	return left, top, right, bottom
+}
+
+/*
+new{{$Name}}FromEdges creates a rect from its left, top, right and bottom edges.
+*/
+func new{{$Name}}FromEdges(left, top, right, bottom {{$TypeName}}) {{$Name}} {
+	return {{$Name}}{Left: left, Top: top, Width: right - left, Height: bottom - top}
+}
+
+/*
+Union returns the smallest rect that contains both this rect and another.
+
+Params:
+  - other: the rect to include.
+
+Returns:
+  - The {{$Name}} bounding both rects.
+*/
+func (r {{$Name}}) Union(other {{$Name}}) {{$Name}} {
+	left, top, right, bottom := r.edges()
+	otherLeft, otherTop, otherRight, otherBottom := other.edges()
+	return new{{$Name}}FromEdges(min(left, otherLeft), min(top, otherTop), max(right, otherRight), max(bottom, otherBottom))
+}
+
+/*
+Intersection returns the overlapping area of this rect and another. It is the
+pure-Go equivalent of Intersects.
+
+Params:
+  - other: the rect to intersect with.
+
+Returns:
+  - The overlapping area, and false (with an empty rect) if the rects don't overlap.
+*/
+func (r {{$Name}}) Intersection(other {{$Name}}) ({{$Name}}, bool) {
+	left, top, right, bottom := r.edges()
+	otherLeft, otherTop, otherRight, otherBottom := other.edges()
+	interLeft, interTop := max(left, otherLeft), max(top, otherTop)
+	interRight, interBottom := min(right, otherRight), min(bottom, otherBottom)
+	if interLeft >= interRight || interTop >= interBottom {
+		return {{$Name}}{}, false
+	}
+	return new{{$Name}}FromEdges(interLeft, interTop, interRight, interBottom), true
+}
+
+/*
+Overlaps reports whether this rect and another share any area. Rects that only
+touch along an edge don't overlap.
+
+Params:
+  - other: the rect to test.
+
+Returns:
+  - Boolean indicating overlap.
+*/
+func (r {{$Name}}) Overlaps(other {{$Name}}) bool {
+	_, ok := r.Intersection(other)
+	return ok
+}
+
+/*
+ContainsRect reports whether another rect lies entirely inside this one.
+
+Params:
+  - other: the rect to test.
+
+Returns:
+  - Boolean indicating containment.
+*/
+func (r {{$Name}}) ContainsRect(other {{$Name}}) bool {
+	left, top, right, bottom := r.edges()
+	otherLeft, otherTop, otherRight, otherBottom := other.edges()
+	return otherLeft >= left && otherTop >= top && otherRight <= right && otherBottom <= bottom
+}
+
+/*
+Clip returns the part of this rect that lies inside bounds. Unlike Intersection,
+it always returns a rect: if the rects don't overlap, the result is empty and
+placed on the nearest edge of bounds.
+
+Params:
+  - bounds: the rect to clip to.
+
+Returns:
+  - The {{$Name}} inside bounds.
+*/
+func (r {{$Name}}) Clip(bounds {{$Name}}) {{$Name}} {
+	left, top, right, bottom := r.edges()
+	boundsLeft, boundsTop, boundsRight, boundsBottom := bounds.edges()
+	clamp := func(v, lo, hi {{$TypeName}}) {{$TypeName}} {
+		return max(lo, min(hi, v))
+	}
+	left, right = clamp(left, boundsLeft, boundsRight), clamp(right, boundsLeft, boundsRight)
+	top, bottom = clamp(top, boundsTop, boundsBottom), clamp(bottom, boundsTop, boundsBottom)
+	return new{{$Name}}FromEdges(left, top, right, bottom)
+}
+
+/*
+Center returns the center point of the rect.
+{{- if not $HasFloat }} Odd sizes round toward the top-left.{{ end }}
+
+Returns:
+  - The {{$VectorName}} at the center.
+*/
+func (r {{$Name}}) Center() {{$VectorName}} {
+	return {{$VectorName}}{
+		X: r.Left + r.Width/2,
+		Y: r.Top + r.Height/2,
+	}
+}
+
+/*
+Inflate grows the rect by dx on the left and right and by dy on the top and
+bottom, keeping its center. Negative values shrink it.
+
+Params:
+  - dx: the horizontal growth on each side.
+  - dy: the vertical growth on each side.
+
+Returns:
+  - The inflated {{$Name}}.
+*/
+func (r {{$Name}}) Inflate(dx, dy {{$TypeName}}) {{$Name}} {
+	return {{$Name}}{
+		Left:   r.Left - dx,
+		Top:    r.Top - dy,
+		Width:  r.Width + 2*dx,
+		Height: r.Height + 2*dy,
+	}
+}
+
+/*
+Translate moves the rect by an offset.
+
+Params:
+  - offset: the distance to move by.
+
+Returns:
+  - The moved {{$Name}}.
+*/
+func (r {{$Name}}) Translate(offset {{$VectorName}}) {{$Name}} {
+	return {{$Name}}{
+		Left:   r.Left + offset.X,
+		Top:    r.Top + offset.Y,
+		Width:  r.Width,
+		Height: r.Height,
+	}
+}
+
+/*
+Corners returns the four corners of the rect.
+
+Returns:
+  - The top-left, top-right, bottom-right and bottom-left corners, in that order.
+*/
+func (r {{$Name}}) Corners() [4]{{$VectorName}} {
+	right, bottom := r.Left+r.Width, r.Top+r.Height
+	return [4]{{$VectorName}}{
+		{X: r.Left, Y: r.Top},
+		{X: right, Y: r.Top},
+		{X: right, Y: bottom},
+		{X: r.Left, Y: bottom},
+	}
+}
+
+{{ if $HasFloat -}}
+/*
+To{{$OtherName}} converts the rect to the smallest {{$OtherName}} that contains it,
+rounding the edges outward.
+
+Returns:
+  - The {{$OtherName}}.
+*/
+func (r {{$Name}}) To{{$OtherName}}() {{$OtherName}} {
+	left, top, right, bottom := r.edges()
+	l, t := int32(math.Floor(float64(left))), int32(math.Floor(float64(top)))
+	return {{$OtherName}}{
+		Left:   l,
+		Top:    t,
+		Width:  int32(math.Ceil(float64(right))) - l,
+		Height: int32(math.Ceil(float64(bottom))) - t,
+	}
+}
+{{- else -}}
+/*
+To{{$OtherName}} converts the rect to a {{$OtherName}}.
+
+Returns:
+  - The {{$OtherName}} with the same edges.
+*/
+func (r {{$Name}}) To{{$OtherName}}() {{$OtherName}} {
+	return {{$OtherName}}{
+		Left:   float32(r.Left),
+		Top:    float32(r.Top),
+		Width:  float32(r.Width),
+		Height: float32(r.Height),
+	}
+}
+{{- end }}
+
+/*
+String returns a formatted string representation of the rect.
+*/
+func (r {{$Name}}) String() string {
+	{{ if $HasFloat -}}
+	return fmt.Sprintf("{{$Name}}(Left: %f, Top: %f, Width: %f, Height: %f)", r.Left, r.Top, r.Width, r.Height)
+	{{- else -}}
+	return fmt.Sprintf("{{$Name}}(Left: %d, Top: %d, Width: %d, Height: %d)", r.Left, r.Top, r.Width, r.Height)
+	{{- end }}
+}
+{{- end }}
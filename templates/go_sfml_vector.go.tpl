@@ -3,6 +3,8 @@
 {{- $Name := .Name -}}
 {{- $Components := .Components -}}
 {{- $HasFloat := .HasFloat -}}
+{{- $IsSigned := .IsSigned -}}
+{{- $Suffix := .Suffix -}}
 
 // ------------------- {{$Name}} Methods -------------------
 
@@ -16,8 +18,8 @@ Params:
 Returns:
   - A new {{$Name}} representing the result of the addition.
 */
-func (v *{{$Name}}) Add(other *{{$Name}}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) Add(other {{$Name}}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} + other.{{.}},
 		{{- end }}
@@ -34,8 +36,8 @@ Params:
 Returns:
   - A new {{$Name}} representing the result of the subtraction.
 */
-func (v *{{$Name}}) Subtract(other *{{$Name}}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) Subtract(other {{$Name}}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} - other.{{.}},
 		{{- end }}
@@ -52,8 +54,8 @@ Params:
 Returns:
   - A new {{$Name}} with each component multiplied.
 */
-func (v *{{$Name}}) Multiply(other *{{$Name}}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) Multiply(other {{$Name}}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} * other.{{.}},
 		{{- end }}
@@ -69,8 +71,8 @@ Params:
 Returns:
   - A new {{$Name}} with each component scaled.
 */
-func (v *{{$Name}}) MultiplyScalar(scalar {{$TypeName}}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) MultiplyScalar(scalar {{$TypeName}}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} * scalar,
 		{{- end }}
@@ -86,8 +88,8 @@ Params:
 Returns:
   - A new {{$Name}} with each component multiplied by its corresponding scalar.
 */
-func (v *{{$Name}}) MultiplyScalars({{ range $i, $e := $Components }}{{ if $i }}, {{ end }}{{ $e | ToLower }} {{ $TypeName }}{{ end }}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) MultiplyScalars({{ range $i, $e := $Components }}{{ if $i }}, {{ end }}{{ $e | ToLower }} {{ $TypeName }}{{ end }}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} * {{. | ToLower}},
 		{{- end }}
@@ -103,8 +105,8 @@ Params:
 Returns:
   - A new {{$Name}} with each component divided.
 */
-func (v *{{$Name}}) Divide(other *{{$Name}}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) Divide(other {{$Name}}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} / other.{{.}},
 		{{- end }}
@@ -120,8 +122,8 @@ Params:
 Returns:
   - A new {{$Name}} with each component divided by scalar.
 */
-func (v *{{$Name}}) DivideScalar(scalar {{$TypeName}}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) DivideScalar(scalar {{$TypeName}}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} / scalar,
 		{{- end }}
@@ -137,8 +139,8 @@ Params:
 Returns:
   - A new {{$Name}} with each component divided.
 */
-func (v *{{$Name}}) DivideScalars({{ range $i, $e := $Components }}{{ if $i }}, {{ end }}{{ $e | ToLower }} {{ $TypeName }}{{ end }}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) DivideScalars({{ range $i, $e := $Components }}{{ if $i }}, {{ end }}{{ $e | ToLower }} {{ $TypeName }}{{ end }}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} / {{. | ToLower}},
 		{{- end }}
@@ -154,14 +156,14 @@ Params:
 Returns:
   - Boolean indicating equality.
 */
-func (v *{{$Name}}) Equals(other *{{$Name}}) bool {
+func (v {{$Name}}) Equals(other {{$Name}}) bool {
 	return {{ range $i, $e := $Components }}{{ if $i }} && {{ end }}v.{{.}} == other.{{.}}{{ end }}
 }
 
 /*
 String returns a formatted string representation of the vector.
 */
-func (v *{{$Name}}) String() string {
+func (v {{$Name}}) String() string {
 	{{ if $HasFloat -}}
 	return fmt.Sprintf("{{$Name}}({{- range $i, $e := $Components }}{{ if $i }}, {{ end }}{{.}}: %f{{ end }})", {{ range $i, $e := $Components }}{{ if $i }}, {{ end }}v.{{.}}{{ end }})
 	{{- else -}}
@@ -169,79 +171,299 @@ func (v *{{$Name}}) String() string {
 	{{- end }}
 }
 
-{{ if $HasFloat }}
-// --- Float-Specific {{$Name}} Methods ---
-
 /*
 LengthSquared returns the squared magnitude of the vector.
 
 Returns:
   - Sum of squares of components.
 */
-func (v *{{$Name}}) LengthSquared() {{$TypeName}} {
+func (v {{$Name}}) LengthSquared() {{$TypeName}} {
 	return {{ range $i, $e := $Components }}{{ if $i }} + {{ end }}v.{{.}}*v.{{.}}{{ end }}
 }
 
 /*
-Length returns the Euclidean length (magnitude) of the vector.
+Dot returns the dot product with another vector.
+
+Params:
+  - other: the vector to dot with.
 
 Returns:
-  - Square root of LengthSquared.
+  - Dot product (scalar).
 */
-func (v *{{$Name}}) Length() {{$TypeName}} {
-	return {{$TypeName}}(math.Sqrt(float64(v.LengthSquared())))
+func (v {{$Name}}) Dot(other {{$Name}}) {{$TypeName}} {
+	return {{ range $i, $e := $Components }}{{ if $i }} + {{ end }}v.{{.}}*other.{{.}}{{ end }}
 }
 
 /*
-Normalize returns a unit vector pointing in the same direction.
+DistanceSquared returns the squared distance between two vectors.
+
+Params:
+  - other: the vector to measure distance to.
 
 Returns:
-  - A normalized vector, or zero vector if original length is 0.
+  - Squared distance (faster if exact distance isn't needed).
 */
-func (v *{{$Name}}) Normalize() *{{$Name}} {
-	if l := v.Length(); l != 0 {
-		return v.DivideScalar(l)
+func (v {{$Name}}) DistanceSquared(other {{$Name}}) {{$TypeName}} {
+	{{- if $IsSigned }}
+	return {{ range $i, $e := $Components }}{{ if $i }} + {{ end }}(v.{{.}} - other.{{.}})*(v.{{.}} - other.{{.}}){{ end }}
+	{{- else }}
+	// Subtract the smaller component, so the difference doesn't wrap around
+	{{- range $Components }}
+	{{. | ToLower}} := max(v.{{.}}, other.{{.}}) - min(v.{{.}}, other.{{.}})
+	{{- end }}
+	return {{ range $i, $e := $Components }}{{ if $i }} + {{ end }}{{. | ToLower}}*{{. | ToLower}}{{ end }}
+	{{- end }}
+}
+
+/*
+Min returns the component-wise minimum of this vector and another.
+
+Params:
+  - other: the vector to compare with.
+
+Returns:
+  - A new {{$Name}} with the smaller of each component.
+*/
+func (v {{$Name}}) Min(other {{$Name}}) {{$Name}} {
+	return {{$Name}}{
+		{{- range $Components }}
+		{{.}}: min(v.{{.}}, other.{{.}}),
+		{{- end }}
 	}
-	return &{{$Name}}{}
 }
 
 /*
-Dot returns the dot product with another vector.
+Max returns the component-wise maximum of this vector and another.
 
 Params:
-  - other: the vector to dot with.
+  - other: the vector to compare with.
 
 Returns:
-  - Dot product (scalar).
+  - A new {{$Name}} with the larger of each component.
 */
-func (v *{{$Name}}) Dot(other *{{$Name}}) {{$TypeName}} {
-	return {{ range $i, $e := $Components }}{{ if $i }} + {{ end }}v.{{.}}*other.{{.}}{{ end }}
+func (v {{$Name}}) Max(other {{$Name}}) {{$Name}} {
+	return {{$Name}}{
+		{{- range $Components }}
+		{{.}}: max(v.{{.}}, other.{{.}}),
+		{{- end }}
+	}
 }
+{{ range .Conversions }}
+/*
+To{{.Name}} converts the vector to a {{.Name}}.
+{{- if and $HasFloat (not .HasFloat) }}
+Components are truncated toward zero; use Floor or Round first to round
+differently.
+{{- if not .IsSigned }} Negative components become 0, as Go leaves converting
+them to an unsigned integer up to the platform.
+{{- end }}
+{{- else if and (not $HasFloat) (not .HasFloat) (ne $IsSigned .IsSigned) }}
+Components wrap around like Go integer conversions, so
+{{- if $IsSigned }} negative ones become
+large, e.g. -1 becomes 4294967295.
+{{- else }} ones above
+2147483647 become negative.
+{{- end }}
+{{- end }}
 
+Returns:
+  - A new {{.Name}}.
+*/
+func (v {{$Name}}) To{{.Name}}() {{.Name}} {
+	return {{.Name}}{
+		{{- $TargetType := .TypeName }}
+		{{- $Clamp := and $HasFloat (not .IsSigned) }}
+		{{- range $Components }}
+		{{- if $Clamp }}
+		{{.}}: {{$TargetType}}(max(0, v.{{.}})),
+		{{- else }}
+		{{.}}: {{$TargetType}}(v.{{.}}),
+		{{- end }}
+		{{- end }}
+	}
+}
+{{ end }}
+{{- if eq (len $Components) 3 }}
 /*
-Distance returns the Euclidean distance between two vectors.
+XY returns the X and Y components as a 2D vector.
+*/
+func (v {{$Name}}) XY() Vector2{{$Suffix}} {
+	return Vector2{{$Suffix}}{X: v.X, Y: v.Y}
+}
+
+/*
+XZ returns the X and Z components as a 2D vector.
+*/
+func (v {{$Name}}) XZ() Vector2{{$Suffix}} {
+	return Vector2{{$Suffix}}{X: v.X, Y: v.Z}
+}
+
+/*
+YZ returns the Y and Z components as a 2D vector.
+*/
+func (v {{$Name}}) YZ() Vector2{{$Suffix}} {
+	return Vector2{{$Suffix}}{X: v.Y, Y: v.Z}
+}
+{{ end }}
+{{- if eq (len $Components) 4 }}
+/*
+XY returns the X and Y components as a 2D vector.
+*/
+func (v {{$Name}}) XY() Vector2{{$Suffix}} {
+	return Vector2{{$Suffix}}{X: v.X, Y: v.Y}
+}
+
+/*
+XYZ returns the X, Y and Z components as a 3D vector.
+*/
+func (v {{$Name}}) XYZ() Vector3{{$Suffix}} {
+	return Vector3{{$Suffix}}{X: v.X, Y: v.Y, Z: v.Z}
+}
+{{ end }}
+{{ if $IsSigned }}
+// --- Signed {{$Name}} Methods ---
+
+/*
+Abs returns the vector with the absolute value of each component.
+
+Returns:
+  - A new {{$Name}} with non-negative components.
+*/
+func (v {{$Name}}) Abs() {{$Name}} {
+	return {{$Name}}{
+		{{- range $Components }}
+		{{- if $HasFloat }}
+		{{.}}: {{$TypeName}}(math.Abs(float64(v.{{.}}))),
+		{{- else }}
+		{{.}}: max(v.{{.}}, -v.{{.}}),
+		{{- end }}
+		{{- end }}
+	}
+}
+
+/*
+Negate returns the vector pointing in the opposite direction.
+
+Returns:
+  - A new {{$Name}} with each component negated.
+*/
+func (v {{$Name}}) Negate() {{$Name}} {
+	return {{$Name}}{
+		{{- range $Components }}
+		{{.}}: -v.{{.}},
+		{{- end }}
+	}
+}
+{{ if eq (len $Components) 2 }}
+/*
+Perpendicular returns the vector rotated by 90 degrees, counterclockwise in a
+Y-up space (clockwise on screen, where Y points down).
+
+Returns:
+  - A new {{$Name}} perpendicular to this one.
+*/
+func (v {{$Name}}) Perpendicular() {{$Name}} {
+	return {{$Name}}{X: -v.Y, Y: v.X}
+}
+
+/*
+Cross returns the Z component of the 3D cross product of this vector and
+another, both taken with Z = 0. Its sign tells on which side other lies.
+
+Params:
+  - other: the vector to cross with.
+
+Returns:
+  - The scalar cross product.
+*/
+func (v {{$Name}}) Cross(other {{$Name}}) {{$TypeName}} {
+	return v.X*other.Y - v.Y*other.X
+}
+{{ end }}
+{{- if eq (len $Components) 3 }}
+/*
+Cross returns the cross product of this vector and another.
+
+Params:
+  - other: the vector to cross with.
+
+Returns:
+  - A new {{$Name}} perpendicular to both vectors.
+*/
+func (v {{$Name}}) Cross(other {{$Name}}) {{$Name}} {
+	return {{$Name}}{
+		X: v.Y*other.Z - v.Z*other.Y,
+		Y: v.Z*other.X - v.X*other.Z,
+		Z: v.X*other.Y - v.Y*other.X,
+	}
+}
+{{ end }}
+{{- end }}
+{{ if not $HasFloat }}
+// --- Integer {{$Name}} Methods ---
+
+/*
+Length returns the Euclidean length (magnitude) of the vector, computed in
+float64 so it isn't truncated.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v {{$Name}}) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
+}
+
+/*
+Distance returns the Euclidean distance between two vectors, computed in float64
+so it isn't truncated.
 
 Params:
   - other: the vector to measure distance to.
 
 Returns:
-  - Distance as a float.
+  - Distance as a float64.
 */
-func (v *{{$Name}}) Distance(other *{{$Name}}) {{$TypeName}} {
-	return {{$TypeName}}(math.Sqrt(float64(v.DistanceSquared(other))))
+func (v {{$Name}}) Distance(other {{$Name}}) float64 {
+	return math.Sqrt(float64(v.DistanceSquared(other)))
 }
+{{ end }}
+{{ if $HasFloat }}
+// --- Float-Specific {{$Name}} Methods ---
 
 /*
-DistanceSquared returns the squared distance between two vectors.
+Length returns the Euclidean length (magnitude) of the vector.
+
+Returns:
+  - Square root of LengthSquared.
+*/
+func (v {{$Name}}) Length() {{$TypeName}} {
+	return {{$TypeName}}(math.Sqrt(float64(v.LengthSquared())))
+}
+
+/*
+Normalize returns a unit vector pointing in the same direction.
+
+Returns:
+  - A normalized vector, or zero vector if original length is 0.
+*/
+func (v {{$Name}}) Normalize() {{$Name}} {
+	if l := v.Length(); l != 0 {
+		return v.DivideScalar(l)
+	}
+	return {{$Name}}{}
+}
+
+/*
+Distance returns the Euclidean distance between two vectors.
 
 Params:
   - other: the vector to measure distance to.
 
 Returns:
-  - Squared distance (faster if exact distance isn't needed).
+  - Distance as a float.
 */
-func (v *{{$Name}}) DistanceSquared(other *{{$Name}}) {{$TypeName}} {
-	return {{ range $i, $e := $Components }}{{ if $i }} + {{ end }}(v.{{.}} - other.{{.}})*(v.{{.}} - other.{{.}}){{ end }}
+func (v {{$Name}}) Distance(other {{$Name}}) {{$TypeName}} {
+	return {{$TypeName}}(math.Sqrt(float64(v.DistanceSquared(other))))
 }
 
 /*
@@ -254,8 +476,8 @@ Params:
 Returns:
   - Interpolated vector between this and other.
 */
-func (v *{{$Name}}) Lerp(other *{{$Name}}, t {{$TypeName}}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) Lerp(other {{$Name}}, t {{$TypeName}}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} + (other.{{.}} - v.{{.}})*t,
 		{{- end }}
@@ -272,8 +494,8 @@ Params:
 Returns:
   - Clamped vector.
 */
-func (v *{{$Name}}) Clamp(min, max *{{$Name}}) *{{$Name}} {
-	return &{{$Name}}{
+func (v {{$Name}}) Clamp(min, max {{$Name}}) {{$Name}} {
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: {{$TypeName}}(math.Max(float64(min.{{.}}), math.Min(float64(max.{{.}}), float64(v.{{.}})))),
 		{{- end }}
@@ -289,9 +511,9 @@ Params:
 Returns:
   - Reflected vector.
 */
-func (v *{{$Name}}) Reflect(normal *{{$Name}}) *{{$Name}} {
+func (v {{$Name}}) Reflect(normal {{$Name}}) {{$Name}} {
 	dot := v.Dot(normal)
-	return &{{$Name}}{
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: v.{{.}} - 2*dot*normal.{{.}},
 		{{- end }}
@@ -307,14 +529,14 @@ Params:
 Returns:
   - Projected vector.
 */
-func (v *{{$Name}}) Project(other *{{$Name}}) *{{$Name}} {
+func (v {{$Name}}) Project(other {{$Name}}) {{$Name}} {
 	dot := v.Dot(other)
 	lengthSquared := other.LengthSquared()
 	if lengthSquared == 0 {
-		return &{{$Name}}{}
+		return {{$Name}}{}
 	}
 	scalar := dot / lengthSquared
-	return &{{$Name}}{
+	return {{$Name}}{
 		{{- range $Components }}
 		{{.}}: other.{{.}} * scalar,
 		{{- end }}
@@ -330,13 +552,85 @@ Params:
 Returns:
   - Rescaled vector, or zero vector if original length is zero.
 */
-func (v *{{$Name}}) SetLength(length {{$TypeName}}) *{{$Name}} {
+func (v {{$Name}}) SetLength(length {{$TypeName}}) {{$Name}} {
 	if v.Length() == 0 {
-		return &{{$Name}}{}
+		return {{$Name}}{}
 	}
 	return v.Normalize().MultiplyScalar(length)
 }
 
+/*
+Floor rounds each component down.
+
+Returns:
+  - A new {{$Name}} with whole-number components.
+*/
+func (v {{$Name}}) Floor() {{$Name}} {
+	return {{$Name}}{
+		{{- range $Components }}
+		{{.}}: {{$TypeName}}(math.Floor(float64(v.{{.}}))),
+		{{- end }}
+	}
+}
+
+/*
+Ceil rounds each component up.
+
+Returns:
+  - A new {{$Name}} with whole-number components.
+*/
+func (v {{$Name}}) Ceil() {{$Name}} {
+	return {{$Name}}{
+		{{- range $Components }}
+		{{.}}: {{$TypeName}}(math.Ceil(float64(v.{{.}}))),
+		{{- end }}
+	}
+}
+
+/*
+Round rounds each component to the nearest whole number, halves away from zero.
+
+Returns:
+  - A new {{$Name}} with whole-number components.
+*/
+func (v {{$Name}}) Round() {{$Name}} {
+	return {{$Name}}{
+		{{- range $Components }}
+		{{.}}: {{$TypeName}}(math.Round(float64(v.{{.}}))),
+		{{- end }}
+	}
+}
+
+/*
+AngleTo returns the unsigned angle between this vector and another.
+
+Params:
+  - other: the vector to measure the angle to.
+
+Returns:
+  - Angle in degrees in [0, 180], or 0 if either vector has length 0.
+*/
+func (v {{$Name}}) AngleTo(other {{$Name}}) {{$TypeName}} {
+	lengths := float64(v.Length()) * float64(other.Length())
+	if lengths == 0 {
+		return 0
+	}
+	cos := math.Max(-1, math.Min(1, float64(v.Dot(other))/lengths))
+	return {{$TypeName}}(math.Acos(cos) * (180.0 / math.Pi))
+}
+
+{{ if eq (len $Components) 2 -}}
+/*
+Angle returns the direction of the vector, measured from the X axis toward the Y
+axis like Rotate.
+
+Returns:
+  - Angle in degrees in (-180, 180].
+*/
+func (v {{$Name}}) Angle() {{$TypeName}} {
+	return {{$TypeName}}(math.Atan2(float64(v.Y), float64(v.X)) * (180.0 / math.Pi))
+}
+{{ end }}
 {{ if eq (len $Components) 2 }}
 /*
 Rotate rotates a 2D vector by a given angle in degrees.
@@ -347,11 +641,11 @@ Params:
 Returns:
   - Rotated vector.
 */
-func (v *{{$Name}}) Rotate(angle {{$TypeName}}) *{{$Name}} {
+func (v {{$Name}}) Rotate(angle {{$TypeName}}) {{$Name}} {
 	radians := angle * (math.Pi / 180.0)
 	cos := {{$TypeName}}(math.Cos(float64(radians)))
 	sin := {{$TypeName}}(math.Sin(float64(radians)))
-	return &{{$Name}}{
+	return {{$Name}}{
 		X: v.X*cos - v.Y*sin,
 		Y: v.X*sin + v.Y*cos,
 	}
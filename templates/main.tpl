@@ -6,6 +6,9 @@ import (
 	"math"
 )
 
+// Vector methods take and return values rather than pointers, so chained math
+// like a.Add(b).MultiplyScalar(2) needs no heap allocations.
+
 {{ range . }}
 {{ template "go_sfml_vector.go.tpl" . }}
 {{ end }}
\ No newline at end of file